@@ -9,6 +9,7 @@ import (
 	deliveryGrpc "cs2-marketplace-microservices/inventory-service/internal/delivery/grpc"
 	"cs2-marketplace-microservices/inventory-service/internal/repository/mongo"
 	"cs2-marketplace-microservices/inventory-service/internal/usecase"
+	"cs2-marketplace-microservices/inventory-service/pkg/config"
 	"cs2-marketplace-microservices/inventory-service/pkg/database"
 	"cs2-marketplace-microservices/inventory-service/pkg/messaging"
 	"cs2-marketplace-microservices/inventory-service/pkg/metrics"
@@ -19,6 +20,8 @@ import (
 )
 
 func main() {
+	cfg := config.LoadConfig()
+
 	// Start metrics server in a separate goroutine
 	go func() {
 		http.Handle("/metrics", promhttp.Handler())
@@ -50,6 +53,14 @@ func main() {
 
 	// 2. Setup layers
 	repo := mongo.NewInventoryRepository(client.Database("cs2_skins"))
+	migrated, err := repo.MigrateMoney(context.Background(), cfg.Currency)
+	if err != nil {
+		log.Fatalf("Failed to migrate prices: %v", err)
+	}
+	if migrated > 0 {
+		log.Printf("Migrated %d skin prices to %s", migrated, cfg.Currency)
+	}
+
 	uc := usecase.NewInventoryUsecase(repo, natsClient)
 	handler := deliveryGrpc.NewHandler(*uc)
 
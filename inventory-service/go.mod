@@ -3,6 +3,7 @@ module cs2-marketplace-microservices/inventory-service
 go 1.24.2
 
 require (
+	cs2-marketplace-microservices/shared v0.0.0
 	github.com/joho/godotenv v1.5.1
 	github.com/patrickmn/go-cache v2.1.0+incompatible
 	github.com/prometheus/client_golang v1.22.0
@@ -38,3 +39,5 @@ require (
 	golang.org/x/text v0.24.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250218202821-56aae31c358a // indirect
 )
+
+replace cs2-marketplace-microservices/shared => ../shared
@@ -2,8 +2,13 @@ package grpc
 
 import (
 	"context"
+	"cs2-marketplace-microservices/inventory-service/internal/models"
 	"cs2-marketplace-microservices/inventory-service/internal/usecase"
 	"cs2-marketplace-microservices/inventory-service/proto/inventory"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Handler struct {
@@ -43,6 +48,14 @@ func (h *Handler) TransferOwnership(ctx context.Context, req *inventory.Transfer
 	return h.uc.TransferOwnership(ctx, req)
 }
 
+func (h *Handler) SwapOwnership(ctx context.Context, req *inventory.SwapOwnershipRequest) (*inventory.SwapOwnershipResponse, error) {
+	resp, err := h.uc.SwapOwnership(ctx, req)
+	if errors.Is(err, models.ErrSkinNotOwned) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return resp, err
+}
+
 func (h *Handler) GetSkinsByOwner(ctx context.Context, req *inventory.GetSkinRequest) (*inventory.ListSkinsResponse, error) {
 	return h.uc.GetSkinsByOwner(ctx, req)
 }
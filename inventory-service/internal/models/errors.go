@@ -0,0 +1,7 @@
+package models
+
+import "errors"
+
+// ErrSkinNotOwned is returned when a skin is no longer owned by the owner an
+// ownership change expected, or no longer listed at the price it expected
+var ErrSkinNotOwned = errors.New("skin is not owned by the expected owner or its listing changed")
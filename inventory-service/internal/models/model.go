@@ -2,6 +2,7 @@ package models
 
 import (
 	"cs2-marketplace-microservices/inventory-service/proto/inventory"
+	"errors"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -11,7 +12,7 @@ type Skin struct {
 	ID          primitive.ObjectID `bson:"_id,omitempty"`
 	Name        string             `bson:"name"`
 	Description string             `bson:"description"`
-	Price       float64            `bson:"price"`
+	Price       Money              `bson:"price"`
 	Image       string             `bson:"image"`
 	Rarity      string             `bson:"rarity"`
 	Condition   string             `bson:"condition"`
@@ -27,7 +28,7 @@ func (s *Skin) ToProto() *inventory.Skin {
 		Id:          s.ID.Hex(),
 		Name:        s.Name,
 		Description: s.Description,
-		Price:       s.Price,
+		Price:       s.Price.ToProto(),
 		Image:       s.Image,
 		Rarity:      s.Rarity,
 		Condition:   s.Condition,
@@ -60,7 +61,7 @@ func SkinFromProto(p *inventory.Skin) (*Skin, error) {
 		ID:          objID,
 		Name:        p.GetName(),
 		Description: p.GetDescription(),
-		Price:       p.GetPrice(),
+		Price:       MoneyFromProto(p.GetPrice()),
 		Image:       p.GetImage(),
 		Rarity:      p.GetRarity(),
 		Condition:   p.GetCondition(),
@@ -70,3 +71,42 @@ func SkinFromProto(p *inventory.Skin) (*Skin, error) {
 		UpdatedAt:   time.Now(),
 	}, nil
 }
+
+// OwnershipChange moves a skin from ExpectedOwnerID to NewOwnerID. With
+// RequireListed the skin must also still be listed, and with ExpectedPrice
+// it must still have that price.
+type OwnershipChange struct {
+	SkinID          primitive.ObjectID
+	ExpectedOwnerID primitive.ObjectID
+	NewOwnerID      primitive.ObjectID
+	RequireListed   bool
+	ExpectedPrice   *Money
+}
+
+// OwnershipChangeFromProto parses the IDs of an ownership change
+func OwnershipChangeFromProto(p *inventory.OwnershipChange) (OwnershipChange, error) {
+	skinID, err := primitive.ObjectIDFromHex(p.GetSkinId())
+	if err != nil {
+		return OwnershipChange{}, errors.New("invalid skin ID format")
+	}
+	expectedOwnerID, err := primitive.ObjectIDFromHex(p.GetExpectedOwnerId())
+	if err != nil {
+		return OwnershipChange{}, errors.New("invalid expected owner ID format")
+	}
+	newOwnerID, err := primitive.ObjectIDFromHex(p.GetNewOwnerId())
+	if err != nil {
+		return OwnershipChange{}, errors.New("invalid new owner ID format")
+	}
+
+	change := OwnershipChange{
+		SkinID:          skinID,
+		ExpectedOwnerID: expectedOwnerID,
+		NewOwnerID:      newOwnerID,
+		RequireListed:   p.GetRequireListed(),
+	}
+	if p.GetExpectedPrice() != nil {
+		price := MoneyFromProto(p.GetExpectedPrice())
+		change.ExpectedPrice = &price
+	}
+	return change, nil
+}
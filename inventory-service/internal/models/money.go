@@ -0,0 +1,12 @@
+package models
+
+import "cs2-marketplace-microservices/shared/money"
+
+// Money is the amount type shared by all services
+type Money = money.Money
+
+var (
+	MinorUnitDigits = money.MinorUnitDigits
+	ValidCurrency   = money.ValidCurrency
+	MoneyFromProto  = money.FromProto
+)
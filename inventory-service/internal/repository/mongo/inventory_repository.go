@@ -5,6 +5,7 @@ import (
 	"cs2-marketplace-microservices/inventory-service/internal/models"
 	"cs2-marketplace-microservices/inventory-service/proto/inventory"
 	"errors"
+	"fmt"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -100,7 +101,7 @@ func (r *InventoryRepository) UpdateSkin(ctx context.Context, skin *inventory.Sk
 		"$set": bson.M{
 			"name":        skin.GetName(),
 			"description": skin.GetDescription(),
-			"price":       skin.GetPrice(),
+			"price":       models.MoneyFromProto(skin.GetPrice()),
 			"image":       skin.GetImage(),
 			"rarity":      skin.GetRarity(),
 			"condition":   skin.GetCondition(),
@@ -193,3 +194,57 @@ func (r *InventoryRepository) TransferOwnership(ctx context.Context, skinID, new
 	})
 	return err
 }
+
+// SwapOwnership applies all ownership changes in one Mongo transaction. If any
+// skin is not owned by its expected owner, is unlisted while its change
+// requires a listing, or no longer has the price its change expects, none of
+// the changes are applied and ErrSkinNotOwned is returned.
+func (r *InventoryRepository) SwapOwnership(ctx context.Context, changes []models.OwnershipChange) ([]*inventory.Skin, error) {
+	session, err := r.collection.Database().Client().StartSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.EndSession(ctx)
+
+	result, err := session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+		skins := make([]*inventory.Skin, 0, len(changes))
+
+		for _, change := range changes {
+			filter := bson.M{"_id": change.SkinID, "owner_id": change.ExpectedOwnerID}
+			if change.RequireListed {
+				filter["is_listed"] = true
+			}
+			if change.ExpectedPrice != nil {
+				filter["price.units"] = change.ExpectedPrice.Units
+				filter["price.currency"] = change.ExpectedPrice.Currency
+			}
+
+			var skin models.Skin
+			err := r.collection.FindOneAndUpdate(
+				sessCtx,
+				filter,
+				bson.M{"$set": bson.M{
+					"owner_id":   change.NewOwnerID,
+					"is_listed":  false,
+					"updated_at": time.Now(),
+				}},
+				opts,
+			).Decode(&skin)
+			if err != nil {
+				if err == mongo.ErrNoDocuments {
+					return nil, fmt.Errorf("%w: skin %s", models.ErrSkinNotOwned, change.SkinID.Hex())
+				}
+				return nil, err
+			}
+			skins = append(skins, skin.ToProto())
+		}
+
+		return skins, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*inventory.Skin), nil
+}
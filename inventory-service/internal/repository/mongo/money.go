@@ -0,0 +1,11 @@
+package mongo
+
+import (
+	"context"
+	"cs2-marketplace-microservices/shared/money"
+)
+
+// MigrateMoney converts skin prices stored as plain numbers to Money in currency
+func (r *InventoryRepository) MigrateMoney(ctx context.Context, currency string) (int64, error) {
+	return money.MigrateFields(ctx, r.collection, currency, "price")
+}
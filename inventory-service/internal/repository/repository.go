@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"cs2-marketplace-microservices/inventory-service/internal/models"
 	"cs2-marketplace-microservices/inventory-service/proto/inventory"
 )
 
@@ -13,4 +14,5 @@ type InventoryRepository interface {
 	DeleteSkin(ctx context.Context, id string) error
 	ToggleListing(ctx context.Context, id string, isListed bool) error
 	TransferOwnership(ctx context.Context, skinID, newOwnerID string) error
+	SwapOwnership(ctx context.Context, changes []models.OwnershipChange) ([]*inventory.Skin, error)
 }
@@ -2,9 +2,11 @@ package usecase
 
 import (
 	"context"
+	"cs2-marketplace-microservices/inventory-service/internal/models"
 	"cs2-marketplace-microservices/inventory-service/internal/repository"
 	"cs2-marketplace-microservices/inventory-service/pkg/messaging"
 	"cs2-marketplace-microservices/inventory-service/proto/inventory"
+	"cs2-marketplace-microservices/shared/proto/money"
 	"errors"
 	"fmt"
 	"log"
@@ -33,8 +35,8 @@ func NewInventoryUsecase(repo repository.InventoryRepository, nats *messaging.Cl
 }
 
 func (uc *InventoryUsecase) CreateSkin(ctx context.Context, req *inventory.CreateSkinRequest) (*inventory.SkinResponse, error) {
-	if req.GetSkin().GetPrice() <= 0 {
-		return nil, errors.New("price must be positive")
+	if err := validatePrice(req.GetSkin().GetPrice()); err != nil {
+		return nil, err
 	}
 
 	skin, err := uc.repo.CreateSkin(ctx, req.GetSkin())
@@ -107,8 +109,8 @@ func (uc *InventoryUsecase) ListSkins(ctx context.Context, req *inventory.ListSk
 }
 
 func (uc *InventoryUsecase) UpdateSkin(ctx context.Context, req *inventory.UpdateSkinRequest) (*inventory.SkinResponse, error) {
-	if req.GetSkin().GetPrice() <= 0 {
-		return nil, errors.New("price must be positive")
+	if err := validatePrice(req.GetSkin().GetPrice()); err != nil {
+		return nil, err
 	}
 
 	skin, err := uc.repo.UpdateSkin(ctx, req.GetSkin())
@@ -197,6 +199,46 @@ func (uc *InventoryUsecase) TransferOwnership(ctx context.Context, req *inventor
 	return &inventory.SkinResponse{Skin: skin}, nil
 }
 
+// SwapOwnership moves several skins between owners at once, as needed by
+// trades. Either every skin moves or none does.
+func (uc *InventoryUsecase) SwapOwnership(ctx context.Context, req *inventory.SwapOwnershipRequest) (*inventory.SwapOwnershipResponse, error) {
+	if len(req.GetChanges()) == 0 {
+		return nil, errors.New("at least one ownership change is required")
+	}
+
+	changes := make([]models.OwnershipChange, 0, len(req.GetChanges()))
+	seen := make(map[string]bool, len(req.GetChanges()))
+	for _, p := range req.GetChanges() {
+		change, err := models.OwnershipChangeFromProto(p)
+		if err != nil {
+			return nil, err
+		}
+		if seen[p.GetSkinId()] {
+			return nil, fmt.Errorf("skin %s is changed more than once", p.GetSkinId())
+		}
+		seen[p.GetSkinId()] = true
+		changes = append(changes, change)
+	}
+
+	skins, err := uc.repo.SwapOwnership(ctx, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update cache
+	for _, skin := range skins {
+		uc.cache.Set(fmt.Sprintf("skin:%s", skin.GetId()), skin, cache.DefaultExpiration)
+	}
+
+	// Invalidate list caches for both old and new owners
+	for _, change := range req.GetChanges() {
+		uc.invalidateListCaches(change.GetExpectedOwnerId())
+		uc.invalidateListCaches(change.GetNewOwnerId())
+	}
+
+	return &inventory.SwapOwnershipResponse{Skins: skins}, nil
+}
+
 func (uc *InventoryUsecase) GetSkinsByOwner(ctx context.Context, req *inventory.GetSkinRequest) (*inventory.ListSkinsResponse, error) {
 	cacheKey := fmt.Sprintf("list:%s:false", req.GetId())
 
@@ -243,6 +285,17 @@ func (uc *InventoryUsecase) GetListedSkins(ctx context.Context, req *inventory.G
 	return &inventory.ListSkinsResponse{Skins: skins}, nil
 }
 
+// validatePrice checks that a price is positive and has a currency
+func validatePrice(price *money.Money) error {
+	if price.GetUnits() <= 0 {
+		return errors.New("price must be positive")
+	}
+	if !models.ValidCurrency(models.MoneyFromProto(price).Currency) {
+		return errors.New("price must have an ISO 4217 currency code")
+	}
+	return nil
+}
+
 // Helper function to invalidate list caches when data changes
 func (uc *InventoryUsecase) invalidateListCaches(ownerID string) {
 	// Invalidate all list caches for this owner
@@ -9,6 +9,9 @@ import (
 type Config struct {
 	MongoURI string
 	NATSURL  string `envconfig:"NATS_URL" default:"nats://localhost:4222"`
+
+	// Currency of prices stored before prices carried their own currency
+	Currency string
 }
 
 func LoadConfig() *Config {
@@ -16,6 +19,7 @@ func LoadConfig() *Config {
 
 	return &Config{
 		MongoURI: getEnv("MONGO_URI", "mongodb://localhost:27017/cs2_skins_marketplace"),
+		Currency: getEnv("CURRENCY", "USD"),
 	}
 }
 
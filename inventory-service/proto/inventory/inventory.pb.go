@@ -7,6 +7,7 @@
 package inventory
 
 import (
+	money "cs2-marketplace-microservices/shared/proto/money"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -26,12 +27,12 @@ type Skin struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
 	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
 	Rarity        string                 `protobuf:"bytes,6,opt,name=rarity,proto3" json:"rarity,omitempty"`
 	Condition     string                 `protobuf:"bytes,7,opt,name=condition,proto3" json:"condition,omitempty"`
 	OwnerId       string                 `protobuf:"bytes,8,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 	IsListed      bool                   `protobuf:"varint,9,opt,name=is_listed,json=isListed,proto3" json:"is_listed,omitempty"`
+	Price         *money.Money           `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -87,13 +88,6 @@ func (x *Skin) GetDescription() string {
 	return ""
 }
 
-func (x *Skin) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
 func (x *Skin) GetImage() string {
 	if x != nil {
 		return x.Image
@@ -129,6 +123,13 @@ func (x *Skin) GetIsListed() bool {
 	return false
 }
 
+func (x *Skin) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
 type CreateSkinRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Skin          *Skin                  `protobuf:"bytes,1,opt,name=skin,proto3" json:"skin,omitempty"`
@@ -553,7 +554,7 @@ type TransferOwnershipRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SkinId        string                 `protobuf:"bytes,1,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
 	NewOwnerId    string                 `protobuf:"bytes,2,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Price         *money.Money           `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -602,28 +603,198 @@ func (x *TransferOwnershipRequest) GetNewOwnerId() string {
 	return ""
 }
 
-func (x *TransferOwnershipRequest) GetPrice() float64 {
+func (x *TransferOwnershipRequest) GetPrice() *money.Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
+}
+
+// OwnershipChange moves a skin from its expected owner to a new owner
+type OwnershipChange struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SkinId          string                 `protobuf:"bytes,1,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	ExpectedOwnerId string                 `protobuf:"bytes,2,opt,name=expected_owner_id,json=expectedOwnerId,proto3" json:"expected_owner_id,omitempty"`
+	NewOwnerId      string                 `protobuf:"bytes,3,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	RequireListed   bool                   `protobuf:"varint,4,opt,name=require_listed,json=requireListed,proto3" json:"require_listed,omitempty"` // the skin must also still be listed for sale, as for purchases
+	ExpectedPrice   *money.Money           `protobuf:"bytes,5,opt,name=expected_price,json=expectedPrice,proto3" json:"expected_price,omitempty"`  // optional - the skin must also still have this price
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OwnershipChange) Reset() {
+	*x = OwnershipChange{}
+	mi := &file_shared_proto_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnershipChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnershipChange) ProtoMessage() {}
+
+func (x *OwnershipChange) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnershipChange.ProtoReflect.Descriptor instead.
+func (*OwnershipChange) Descriptor() ([]byte, []int) {
+	return file_shared_proto_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *OwnershipChange) GetSkinId() string {
+	if x != nil {
+		return x.SkinId
+	}
+	return ""
+}
+
+func (x *OwnershipChange) GetExpectedOwnerId() string {
+	if x != nil {
+		return x.ExpectedOwnerId
+	}
+	return ""
+}
+
+func (x *OwnershipChange) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
+func (x *OwnershipChange) GetRequireListed() bool {
+	if x != nil {
+		return x.RequireListed
+	}
+	return false
+}
+
+func (x *OwnershipChange) GetExpectedPrice() *money.Money {
+	if x != nil {
+		return x.ExpectedPrice
+	}
+	return nil
+}
+
+// SwapOwnershipRequest applies all changes or none of them. It fails with
+// FAILED_PRECONDITION if any skin is no longer owned by its expected owner,
+// no longer listed when the change requires it, or repriced since the
+// expected price was read.
+type SwapOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*OwnershipChange     `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapOwnershipRequest) Reset() {
+	*x = SwapOwnershipRequest{}
+	mi := &file_shared_proto_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapOwnershipRequest) ProtoMessage() {}
+
+func (x *SwapOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*SwapOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SwapOwnershipRequest) GetChanges() []*OwnershipChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type SwapOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skins         []*Skin                `protobuf:"bytes,1,rep,name=skins,proto3" json:"skins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapOwnershipResponse) Reset() {
+	*x = SwapOwnershipResponse{}
+	mi := &file_shared_proto_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapOwnershipResponse) ProtoMessage() {}
+
+func (x *SwapOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*SwapOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SwapOwnershipResponse) GetSkins() []*Skin {
+	if x != nil {
+		return x.Skins
+	}
+	return nil
 }
 
 var File_shared_proto_inventory_proto protoreflect.FileDescriptor
 
 const file_shared_proto_inventory_proto_rawDesc = "" +
 	"\n" +
-	"\x1cshared/proto/inventory.proto\x12\tinventory\"\xe6\x01\n" +
+	"\x1cshared/proto/inventory.proto\x12\tinventory\x1a\x18shared/proto/money.proto\"\xfa\x01\n" +
 	"\x04Skin\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x14\n" +
 	"\x05image\x18\x05 \x01(\tR\x05image\x12\x16\n" +
 	"\x06rarity\x18\x06 \x01(\tR\x06rarity\x12\x1c\n" +
 	"\tcondition\x18\a \x01(\tR\tcondition\x12\x19\n" +
 	"\bowner_id\x18\b \x01(\tR\aownerId\x12\x1b\n" +
-	"\tis_listed\x18\t \x01(\bR\bisListed\"8\n" +
+	"\tis_listed\x18\t \x01(\bR\bisListed\x12\"\n" +
+	"\x05price\x18\n" +
+	" \x01(\v2\f.money.MoneyR\x05priceJ\x04\b\x04\x10\x05\"8\n" +
 	"\x11CreateSkinRequest\x12#\n" +
 	"\x04skin\x18\x01 \x01(\v2\x0f.inventory.SkinR\x04skin\"3\n" +
 	"\fSkinResponse\x12#\n" +
@@ -644,12 +815,23 @@ const file_shared_proto_inventory_proto_rawDesc = "" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"C\n" +
 	"\x14ToggleListingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\tis_listed\x18\x02 \x01(\bR\bisListed\"k\n" +
+	"\tis_listed\x18\x02 \x01(\bR\bisListed\"\x7f\n" +
 	"\x18TransferOwnershipRequest\x12\x17\n" +
 	"\askin_id\x18\x01 \x01(\tR\x06skinId\x12 \n" +
 	"\fnew_owner_id\x18\x02 \x01(\tR\n" +
-	"newOwnerId\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price2\x9f\x05\n" +
+	"newOwnerId\x12\"\n" +
+	"\x05price\x18\x04 \x01(\v2\f.money.MoneyR\x05priceJ\x04\b\x03\x10\x04\"\xd4\x01\n" +
+	"\x0fOwnershipChange\x12\x17\n" +
+	"\askin_id\x18\x01 \x01(\tR\x06skinId\x12*\n" +
+	"\x11expected_owner_id\x18\x02 \x01(\tR\x0fexpectedOwnerId\x12 \n" +
+	"\fnew_owner_id\x18\x03 \x01(\tR\n" +
+	"newOwnerId\x12%\n" +
+	"\x0erequire_listed\x18\x04 \x01(\bR\rrequireListed\x123\n" +
+	"\x0eexpected_price\x18\x05 \x01(\v2\f.money.MoneyR\rexpectedPrice\"L\n" +
+	"\x14SwapOwnershipRequest\x124\n" +
+	"\achanges\x18\x01 \x03(\v2\x1a.inventory.OwnershipChangeR\achanges\">\n" +
+	"\x15SwapOwnershipResponse\x12%\n" +
+	"\x05skins\x18\x01 \x03(\v2\x0f.inventory.SkinR\x05skins2\xf3\x05\n" +
 	"\x10InventoryService\x12C\n" +
 	"\n" +
 	"CreateSkin\x12\x1c.inventory.CreateSkinRequest\x1a\x17.inventory.SkinResponse\x12=\n" +
@@ -660,7 +842,8 @@ const file_shared_proto_inventory_proto_rawDesc = "" +
 	"\n" +
 	"DeleteSkin\x12\x1c.inventory.DeleteSkinRequest\x1a\x19.inventory.DeleteResponse\x12I\n" +
 	"\rToggleListing\x12\x1f.inventory.ToggleListingRequest\x1a\x17.inventory.SkinResponse\x12Q\n" +
-	"\x11TransferOwnership\x12#.inventory.TransferOwnershipRequest\x1a\x17.inventory.SkinResponse\x12J\n" +
+	"\x11TransferOwnership\x12#.inventory.TransferOwnershipRequest\x1a\x17.inventory.SkinResponse\x12R\n" +
+	"\rSwapOwnership\x12\x1f.inventory.SwapOwnershipRequest\x1a .inventory.SwapOwnershipResponse\x12J\n" +
 	"\x0fGetSkinsByOwner\x12\x19.inventory.GetSkinRequest\x1a\x1c.inventory.ListSkinsResponse\x12I\n" +
 	"\x0eGetListedSkins\x12\x19.inventory.GetSkinRequest\x1a\x1c.inventory.ListSkinsResponseB/Z-cs2-marketplace-microservices/proto/inventoryb\x06proto3"
 
@@ -676,7 +859,7 @@ func file_shared_proto_inventory_proto_rawDescGZIP() []byte {
 	return file_shared_proto_inventory_proto_rawDescData
 }
 
-var file_shared_proto_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_shared_proto_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_shared_proto_inventory_proto_goTypes = []any{
 	(*Skin)(nil),                     // 0: inventory.Skin
 	(*CreateSkinRequest)(nil),        // 1: inventory.CreateSkinRequest
@@ -689,35 +872,46 @@ var file_shared_proto_inventory_proto_goTypes = []any{
 	(*DeleteResponse)(nil),           // 8: inventory.DeleteResponse
 	(*ToggleListingRequest)(nil),     // 9: inventory.ToggleListingRequest
 	(*TransferOwnershipRequest)(nil), // 10: inventory.TransferOwnershipRequest
+	(*OwnershipChange)(nil),          // 11: inventory.OwnershipChange
+	(*SwapOwnershipRequest)(nil),     // 12: inventory.SwapOwnershipRequest
+	(*SwapOwnershipResponse)(nil),    // 13: inventory.SwapOwnershipResponse
+	(*money.Money)(nil),              // 14: money.Money
 }
 var file_shared_proto_inventory_proto_depIdxs = []int32{
-	0,  // 0: inventory.CreateSkinRequest.skin:type_name -> inventory.Skin
-	0,  // 1: inventory.SkinResponse.skin:type_name -> inventory.Skin
-	0,  // 2: inventory.ListSkinsResponse.skins:type_name -> inventory.Skin
-	0,  // 3: inventory.UpdateSkinRequest.skin:type_name -> inventory.Skin
-	1,  // 4: inventory.InventoryService.CreateSkin:input_type -> inventory.CreateSkinRequest
-	3,  // 5: inventory.InventoryService.GetSkin:input_type -> inventory.GetSkinRequest
-	4,  // 6: inventory.InventoryService.ListSkins:input_type -> inventory.ListSkinsRequest
-	6,  // 7: inventory.InventoryService.UpdateSkin:input_type -> inventory.UpdateSkinRequest
-	7,  // 8: inventory.InventoryService.DeleteSkin:input_type -> inventory.DeleteSkinRequest
-	9,  // 9: inventory.InventoryService.ToggleListing:input_type -> inventory.ToggleListingRequest
-	10, // 10: inventory.InventoryService.TransferOwnership:input_type -> inventory.TransferOwnershipRequest
-	3,  // 11: inventory.InventoryService.GetSkinsByOwner:input_type -> inventory.GetSkinRequest
-	3,  // 12: inventory.InventoryService.GetListedSkins:input_type -> inventory.GetSkinRequest
-	2,  // 13: inventory.InventoryService.CreateSkin:output_type -> inventory.SkinResponse
-	2,  // 14: inventory.InventoryService.GetSkin:output_type -> inventory.SkinResponse
-	5,  // 15: inventory.InventoryService.ListSkins:output_type -> inventory.ListSkinsResponse
-	2,  // 16: inventory.InventoryService.UpdateSkin:output_type -> inventory.SkinResponse
-	8,  // 17: inventory.InventoryService.DeleteSkin:output_type -> inventory.DeleteResponse
-	2,  // 18: inventory.InventoryService.ToggleListing:output_type -> inventory.SkinResponse
-	2,  // 19: inventory.InventoryService.TransferOwnership:output_type -> inventory.SkinResponse
-	5,  // 20: inventory.InventoryService.GetSkinsByOwner:output_type -> inventory.ListSkinsResponse
-	5,  // 21: inventory.InventoryService.GetListedSkins:output_type -> inventory.ListSkinsResponse
-	13, // [13:22] is the sub-list for method output_type
-	4,  // [4:13] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	14, // 0: inventory.Skin.price:type_name -> money.Money
+	0,  // 1: inventory.CreateSkinRequest.skin:type_name -> inventory.Skin
+	0,  // 2: inventory.SkinResponse.skin:type_name -> inventory.Skin
+	0,  // 3: inventory.ListSkinsResponse.skins:type_name -> inventory.Skin
+	0,  // 4: inventory.UpdateSkinRequest.skin:type_name -> inventory.Skin
+	14, // 5: inventory.TransferOwnershipRequest.price:type_name -> money.Money
+	14, // 6: inventory.OwnershipChange.expected_price:type_name -> money.Money
+	11, // 7: inventory.SwapOwnershipRequest.changes:type_name -> inventory.OwnershipChange
+	0,  // 8: inventory.SwapOwnershipResponse.skins:type_name -> inventory.Skin
+	1,  // 9: inventory.InventoryService.CreateSkin:input_type -> inventory.CreateSkinRequest
+	3,  // 10: inventory.InventoryService.GetSkin:input_type -> inventory.GetSkinRequest
+	4,  // 11: inventory.InventoryService.ListSkins:input_type -> inventory.ListSkinsRequest
+	6,  // 12: inventory.InventoryService.UpdateSkin:input_type -> inventory.UpdateSkinRequest
+	7,  // 13: inventory.InventoryService.DeleteSkin:input_type -> inventory.DeleteSkinRequest
+	9,  // 14: inventory.InventoryService.ToggleListing:input_type -> inventory.ToggleListingRequest
+	10, // 15: inventory.InventoryService.TransferOwnership:input_type -> inventory.TransferOwnershipRequest
+	12, // 16: inventory.InventoryService.SwapOwnership:input_type -> inventory.SwapOwnershipRequest
+	3,  // 17: inventory.InventoryService.GetSkinsByOwner:input_type -> inventory.GetSkinRequest
+	3,  // 18: inventory.InventoryService.GetListedSkins:input_type -> inventory.GetSkinRequest
+	2,  // 19: inventory.InventoryService.CreateSkin:output_type -> inventory.SkinResponse
+	2,  // 20: inventory.InventoryService.GetSkin:output_type -> inventory.SkinResponse
+	5,  // 21: inventory.InventoryService.ListSkins:output_type -> inventory.ListSkinsResponse
+	2,  // 22: inventory.InventoryService.UpdateSkin:output_type -> inventory.SkinResponse
+	8,  // 23: inventory.InventoryService.DeleteSkin:output_type -> inventory.DeleteResponse
+	2,  // 24: inventory.InventoryService.ToggleListing:output_type -> inventory.SkinResponse
+	2,  // 25: inventory.InventoryService.TransferOwnership:output_type -> inventory.SkinResponse
+	13, // 26: inventory.InventoryService.SwapOwnership:output_type -> inventory.SwapOwnershipResponse
+	5,  // 27: inventory.InventoryService.GetSkinsByOwner:output_type -> inventory.ListSkinsResponse
+	5,  // 28: inventory.InventoryService.GetListedSkins:output_type -> inventory.ListSkinsResponse
+	19, // [19:29] is the sub-list for method output_type
+	9,  // [9:19] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_shared_proto_inventory_proto_init() }
@@ -731,7 +925,7 @@ func file_shared_proto_inventory_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shared_proto_inventory_proto_rawDesc), len(file_shared_proto_inventory_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,6 +26,7 @@ const (
 	InventoryService_DeleteSkin_FullMethodName        = "/inventory.InventoryService/DeleteSkin"
 	InventoryService_ToggleListing_FullMethodName     = "/inventory.InventoryService/ToggleListing"
 	InventoryService_TransferOwnership_FullMethodName = "/inventory.InventoryService/TransferOwnership"
+	InventoryService_SwapOwnership_FullMethodName     = "/inventory.InventoryService/SwapOwnership"
 	InventoryService_GetSkinsByOwner_FullMethodName   = "/inventory.InventoryService/GetSkinsByOwner"
 	InventoryService_GetListedSkins_FullMethodName    = "/inventory.InventoryService/GetListedSkins"
 )
@@ -43,6 +44,7 @@ type InventoryServiceClient interface {
 	// Special operations
 	ToggleListing(ctx context.Context, in *ToggleListingRequest, opts ...grpc.CallOption) (*SkinResponse, error)
 	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*SkinResponse, error)
+	SwapOwnership(ctx context.Context, in *SwapOwnershipRequest, opts ...grpc.CallOption) (*SwapOwnershipResponse, error)
 	// Additional endpoints
 	GetSkinsByOwner(ctx context.Context, in *GetSkinRequest, opts ...grpc.CallOption) (*ListSkinsResponse, error)
 	GetListedSkins(ctx context.Context, in *GetSkinRequest, opts ...grpc.CallOption) (*ListSkinsResponse, error)
@@ -126,6 +128,16 @@ func (c *inventoryServiceClient) TransferOwnership(ctx context.Context, in *Tran
 	return out, nil
 }
 
+func (c *inventoryServiceClient) SwapOwnership(ctx context.Context, in *SwapOwnershipRequest, opts ...grpc.CallOption) (*SwapOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwapOwnershipResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SwapOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) GetSkinsByOwner(ctx context.Context, in *GetSkinRequest, opts ...grpc.CallOption) (*ListSkinsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSkinsResponse)
@@ -159,6 +171,7 @@ type InventoryServiceServer interface {
 	// Special operations
 	ToggleListing(context.Context, *ToggleListingRequest) (*SkinResponse, error)
 	TransferOwnership(context.Context, *TransferOwnershipRequest) (*SkinResponse, error)
+	SwapOwnership(context.Context, *SwapOwnershipRequest) (*SwapOwnershipResponse, error)
 	// Additional endpoints
 	GetSkinsByOwner(context.Context, *GetSkinRequest) (*ListSkinsResponse, error)
 	GetListedSkins(context.Context, *GetSkinRequest) (*ListSkinsResponse, error)
@@ -193,6 +206,9 @@ func (UnimplementedInventoryServiceServer) ToggleListing(context.Context, *Toggl
 func (UnimplementedInventoryServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*SkinResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
 }
+func (UnimplementedInventoryServiceServer) SwapOwnership(context.Context, *SwapOwnershipRequest) (*SwapOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapOwnership not implemented")
+}
 func (UnimplementedInventoryServiceServer) GetSkinsByOwner(context.Context, *GetSkinRequest) (*ListSkinsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSkinsByOwner not implemented")
 }
@@ -346,6 +362,24 @@ func _InventoryService_TransferOwnership_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_SwapOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SwapOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SwapOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SwapOwnership(ctx, req.(*SwapOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InventoryService_GetSkinsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSkinRequest)
 	if err := dec(in); err != nil {
@@ -417,6 +451,10 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TransferOwnership",
 			Handler:    _InventoryService_TransferOwnership_Handler,
 		},
+		{
+			MethodName: "SwapOwnership",
+			Handler:    _InventoryService_SwapOwnership_Handler,
+		},
 		{
 			MethodName: "GetSkinsByOwner",
 			Handler:    _InventoryService_GetSkinsByOwner_Handler,
@@ -0,0 +1,34 @@
+package money
+
+import (
+	"context"
+	"math"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MigrateFields rewrites the fields of collection stored as plain numbers,
+// from before amounts were Money, as minor units of currency. Migrated
+// documents no longer match, so the migration can safely run on every start.
+// It returns the number of fields migrated.
+func MigrateFields(ctx context.Context, collection *mongo.Collection, currency string, fields ...string) (int64, error) {
+	scale := math.Pow10(MinorUnitDigits(currency))
+
+	var migrated int64
+	for _, field := range fields {
+		filter := bson.M{field: bson.M{"$type": "number"}}
+		update := []bson.M{{"$set": bson.M{field: bson.M{
+			"units":    bson.M{"$toLong": bson.M{"$round": bson.A{bson.M{"$multiply": bson.A{"$" + field, scale}}, 0}}},
+			"currency": bson.M{"$literal": currency},
+		}}}}
+
+		result, err := collection.UpdateMany(ctx, filter, update)
+		if err != nil {
+			return migrated, err
+		}
+		migrated += result.ModifiedCount
+	}
+
+	return migrated, nil
+}
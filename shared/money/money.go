@@ -0,0 +1,201 @@
+// Package money holds the Money type shared by the services: an exact amount
+// in the minor unit of a currency, with explicit conversions between
+// currencies.
+package money
+
+import (
+	moneypb "cs2-marketplace-microservices/shared/proto/money"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var (
+	ErrCurrencyMismatch  = errors.New("currency mismatch: an explicit conversion is required")
+	ErrInvalidConversion = errors.New("invalid currency conversion")
+	// ErrOutOfRange is returned when a converted amount does not fit in int64 minor units
+	ErrOutOfRange = errors.New("amount is out of range")
+)
+
+// Money is an exact amount in the minor unit of a currency, e.g. cents for USD
+type Money struct {
+	Units    int64  `bson:"units"`
+	Currency string `bson:"currency"`
+}
+
+// Conversion is an explicit exchange rate: one From is worth Rate To.
+// Rate is kept as the decimal string it was supplied as so it stays exact.
+type Conversion struct {
+	From string `bson:"from"`
+	To   string `bson:"to"`
+	Rate string `bson:"rate"`
+}
+
+// minorUnitDigits lists the currencies whose minor unit is not a hundredth
+var minorUnitDigits = map[string]int{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLP": 0, "ISK": 0, "JPY": 0, "KRW": 0, "PYG": 0, "UGX": 0, "VND": 0, "XAF": 0, "XOF": 0,
+}
+
+// MinorUnitDigits returns the number of decimal digits of a currency's minor unit
+func MinorUnitDigits(currency string) int {
+	if digits, ok := minorUnitDigits[currency]; ok {
+		return digits
+	}
+	return 2
+}
+
+// ValidCurrency reports whether code looks like an ISO 4217 currency code
+func ValidCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// FromProto converts a protobuf amount; a missing amount is zero with no currency
+func FromProto(p *moneypb.Money) Money {
+	return Money{
+		Units:    p.GetUnits(),
+		Currency: strings.ToUpper(p.GetCurrency()),
+	}
+}
+
+// ToProto converts m to its protobuf message
+func (m Money) ToProto() *moneypb.Money {
+	return &moneypb.Money{
+		Units:    m.Units,
+		Currency: m.Currency,
+	}
+}
+
+// Neg returns the amount with the opposite sign
+func (m Money) Neg() Money {
+	return Money{Units: -m.Units, Currency: m.Currency}
+}
+
+// Decimal formats the amount in major units, e.g. "12.50"
+func (m Money) Decimal() string {
+	digits := MinorUnitDigits(m.Currency)
+	if digits == 0 {
+		return fmt.Sprintf("%d", m.Units)
+	}
+
+	// Negated through uint64 so the smallest int64 keeps its magnitude
+	sign, units := "", uint64(m.Units)
+	if m.Units < 0 {
+		sign, units = "-", -units
+	}
+	scale := uint64(pow10(digits))
+	return fmt.Sprintf("%s%d.%0*d", sign, units/scale, digits, units%scale)
+}
+
+// String formats the amount with its currency, e.g. "12.50 USD"
+func (m Money) String() string {
+	return m.Decimal() + " " + m.Currency
+}
+
+// MajorUnits converts an amount in minor units of currency to major units.
+// Only meant for reporting, e.g. averages; it is not exact.
+func MajorUnits(units float64, currency string) float64 {
+	return units / float64(pow10(MinorUnitDigits(currency)))
+}
+
+// ConversionsFromProto converts protobuf exchange rates
+func ConversionsFromProto(p []*moneypb.Conversion) []Conversion {
+	conversions := make([]Conversion, 0, len(p))
+	for _, c := range p {
+		conversions = append(conversions, Conversion{
+			From: strings.ToUpper(c.GetFromCurrency()),
+			To:   strings.ToUpper(c.GetToCurrency()),
+			Rate: c.GetRate(),
+		})
+	}
+	return conversions
+}
+
+// ConversionsToProto converts exchange rates to protobuf messages
+func ConversionsToProto(conversions []Conversion) []*moneypb.Conversion {
+	p := make([]*moneypb.Conversion, 0, len(conversions))
+	for _, c := range conversions {
+		p = append(p, &moneypb.Conversion{
+			FromCurrency: c.From,
+			ToCurrency:   c.To,
+			Rate:         c.Rate,
+		})
+	}
+	return p
+}
+
+// Convert returns m in currency, rounded to the nearest minor unit. Amounts
+// already in currency are returned unchanged; otherwise one of conversions must
+// convert between the two currencies, in either direction.
+func Convert(m Money, currency string, conversions []Conversion) (Money, error) {
+	if m.Currency == currency {
+		return m, nil
+	}
+
+	for _, c := range conversions {
+		inverse := c.From == currency && c.To == m.Currency
+		if !inverse && (c.From != m.Currency || c.To != currency) {
+			continue
+		}
+
+		rate, ok := new(big.Rat).SetString(c.Rate)
+		if !ok || rate.Sign() <= 0 {
+			return Money{}, fmt.Errorf("%w: %s to %s rate %q", ErrInvalidConversion, c.From, c.To, c.Rate)
+		}
+		if inverse {
+			rate.Inv(rate)
+		}
+
+		v := new(big.Rat).SetInt64(m.Units)
+		v.Mul(v, rate)
+
+		// Rescale between the minor units of the two currencies
+		shift := MinorUnitDigits(currency) - MinorUnitDigits(m.Currency)
+		if shift > 0 {
+			v.Mul(v, new(big.Rat).SetInt64(pow10(shift)))
+		} else if shift < 0 {
+			v.Quo(v, new(big.Rat).SetInt64(pow10(-shift)))
+		}
+
+		units, err := roundHalfAway(v)
+		if err != nil {
+			return Money{}, fmt.Errorf("%w: %s in %s", err, m, currency)
+		}
+		return Money{Units: units, Currency: currency}, nil
+	}
+
+	return Money{}, fmt.Errorf("%w: %s to %s", ErrCurrencyMismatch, m.Currency, currency)
+}
+
+// roundHalfAway rounds v to an integer, halves away from zero. It returns
+// ErrOutOfRange if the result does not fit in an int64.
+func roundHalfAway(v *big.Rat) (int64, error) {
+	q, r := new(big.Int).QuoRem(new(big.Int).Abs(v.Num()), v.Denom(), new(big.Int))
+	if r.Lsh(r, 1).Cmp(v.Denom()) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if v.Sign() < 0 {
+		q.Neg(q)
+	}
+	if !q.IsInt64() {
+		return 0, ErrOutOfRange
+	}
+	return q.Int64(), nil
+}
+
+func pow10(n int) int64 {
+	p := int64(1)
+	for i := 0; i < n; i++ {
+		p *= 10
+	}
+	return p
+}
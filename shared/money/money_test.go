@@ -0,0 +1,92 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	usdEur := []Conversion{{From: "USD", To: "EUR", Rate: "0.9"}}
+
+	tests := []struct {
+		name        string
+		m           Money
+		currency    string
+		conversions []Conversion
+		want        Money
+	}{
+		{"same currency", Money{Units: 1250, Currency: "USD"}, "USD", nil, Money{Units: 1250, Currency: "USD"}},
+		{"direct rate", Money{Units: 1000, Currency: "USD"}, "EUR", usdEur, Money{Units: 900, Currency: "EUR"}},
+		{"inverse rate", Money{Units: 900, Currency: "EUR"}, "USD", usdEur, Money{Units: 1000, Currency: "USD"}},
+		{"inverse rate rounds", Money{Units: 100, Currency: "EUR"}, "USD", usdEur, Money{Units: 111, Currency: "USD"}},
+		{"to zero digits", Money{Units: 1000, Currency: "USD"}, "JPY", []Conversion{{From: "USD", To: "JPY", Rate: "150.25"}}, Money{Units: 1503, Currency: "JPY"}},
+		{"from zero digits", Money{Units: 150, Currency: "JPY"}, "USD", []Conversion{{From: "USD", To: "JPY", Rate: "150"}}, Money{Units: 100, Currency: "USD"}},
+		{"to three digits", Money{Units: 1000, Currency: "USD"}, "KWD", []Conversion{{From: "USD", To: "KWD", Rate: "0.3075"}}, Money{Units: 3075, Currency: "KWD"}},
+		{"from three digits", Money{Units: 1005, Currency: "KWD"}, "EUR", []Conversion{{From: "KWD", To: "EUR", Rate: "1"}}, Money{Units: 101, Currency: "EUR"}},
+		{"negative rounds away", Money{Units: -1005, Currency: "KWD"}, "EUR", []Conversion{{From: "KWD", To: "EUR", Rate: "1"}}, Money{Units: -101, Currency: "EUR"}},
+		{"unrelated conversions skipped", Money{Units: 1000, Currency: "USD"}, "EUR", []Conversion{{From: "GBP", To: "EUR", Rate: "1.2"}, usdEur[0]}, Money{Units: 900, Currency: "EUR"}},
+	}
+
+	for _, tt := range tests {
+		got, err := Convert(tt.m, tt.currency, tt.conversions)
+		if err != nil {
+			t.Errorf("%s: Convert: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: Convert = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		m           Money
+		conversions []Conversion
+		want        error
+	}{
+		{"missing conversion", Money{Units: 100, Currency: "USD"}, []Conversion{{From: "GBP", To: "EUR", Rate: "1.2"}}, ErrCurrencyMismatch},
+		{"no conversions", Money{Units: 100, Currency: "USD"}, nil, ErrCurrencyMismatch},
+		{"malformed rate", Money{Units: 100, Currency: "USD"}, []Conversion{{From: "USD", To: "EUR", Rate: "abc"}}, ErrInvalidConversion},
+		{"zero rate", Money{Units: 100, Currency: "USD"}, []Conversion{{From: "USD", To: "EUR", Rate: "0"}}, ErrInvalidConversion},
+		{"negative rate", Money{Units: 100, Currency: "USD"}, []Conversion{{From: "USD", To: "EUR", Rate: "-1"}}, ErrInvalidConversion},
+		{"overflow", Money{Units: math.MaxInt64, Currency: "USD"}, []Conversion{{From: "USD", To: "EUR", Rate: "2"}}, ErrOutOfRange},
+		{"overflow rescaling", Money{Units: math.MinInt64, Currency: "JPY"}, []Conversion{{From: "JPY", To: "EUR", Rate: "1"}}, ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		if _, err := Convert(tt.m, "EUR", tt.conversions); !errors.Is(err, tt.want) {
+			t.Errorf("%s: Convert error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		m    Money
+		want string
+	}{
+		{Money{Units: 1250, Currency: "USD"}, "12.50"},
+		{Money{Units: 5, Currency: "USD"}, "0.05"},
+		{Money{Units: -5, Currency: "USD"}, "-0.05"},
+		{Money{Units: 0, Currency: "USD"}, "0.00"},
+		{Money{Units: 1500, Currency: "JPY"}, "1500"},
+		{Money{Units: -1500, Currency: "JPY"}, "-1500"},
+		{Money{Units: 1005, Currency: "KWD"}, "1.005"},
+		{Money{Units: math.MinInt64, Currency: "USD"}, "-92233720368547758.08"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.m.Decimal(); got != tt.want {
+			t.Errorf("Decimal(%d %s) = %q, want %q", tt.m.Units, tt.m.Currency, got, tt.want)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	if got, want := (Money{Units: 1250, Currency: "EUR"}).String(), "12.50 EUR"; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,46 @@
+// Command candles rebuilds the price candles from every completed purchase,
+// leaving out refunded sales. Run it once after deploying the price history,
+// and again whenever sales were refunded or could not be recorded.
+//
+//	go run ./cmd/candles
+package main
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/pricehistory"
+	repomongo "cs2-marketplace-microservices/transaction-service/internal/repository/mongo"
+	"cs2-marketplace-microservices/transaction-service/pkg/clients"
+	"cs2-marketplace-microservices/transaction-service/pkg/config"
+	"cs2-marketplace-microservices/transaction-service/pkg/database"
+	"log"
+	"os"
+	"os/signal"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	db, err := database.InitDB(cfg.MongoURI)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer database.CloseDB()
+
+	serviceClients, err := clients.New(cfg.InventoryServiceAddr, cfg.UserServiceAddr)
+	if err != nil {
+		log.Fatalf("Failed to create service clients: %v", err)
+	}
+	defer serviceClients.Close()
+
+	rebuilder := pricehistory.New(repomongo.NewTransactionRepository(db), repomongo.NewPriceHistoryRepository(db), serviceClients.Inventory)
+	result, err := rebuilder.Run(ctx)
+	if err != nil {
+		log.Fatalf("Rebuilding price candles failed: %v", err)
+	}
+
+	log.Printf("Rebuilt %d candles from %d sales, leaving out %d refunded sales and %d sales of deleted skins",
+		result.Candles, result.Sales, result.Refunded, result.UnknownSkins)
+}
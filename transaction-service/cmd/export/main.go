@@ -0,0 +1,82 @@
+// Command export writes a user's transaction history to a file or stdout
+// using the ExportTransactions RPC.
+//
+//	go run ./cmd/export -user <id> -format csv -from 2024-01-01T00:00:00Z -out history.csv
+package main
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"errors"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var formats = map[string]transaction.ExportFormat{
+	"csv":   transaction.ExportFormat_CSV,
+	"jsonl": transaction.ExportFormat_JSON_LINES,
+}
+
+func main() {
+	addr := flag.String("addr", "localhost:50053", "transaction service address")
+	userID := flag.String("user", "", "user id to export (required)")
+	format := flag.String("format", "csv", "export format: csv or jsonl")
+	from := flag.String("from", "", "start of the date range, RFC3339 (optional)")
+	to := flag.String("to", "", "end of the date range, RFC3339, exclusive (optional)")
+	out := flag.String("out", "", "output file (default stdout)")
+	flag.Parse()
+
+	exportFormat, ok := formats[*format]
+	if *userID == "" || !ok {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to transaction service: %v", err)
+	}
+	defer conn.Close()
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("Failed to create %s: %v", *out, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	stream, err := transaction.NewTransactionServiceClient(conn).ExportTransactions(ctx, &transaction.ExportTransactionsRequest{
+		UserId:    *userID,
+		StartTime: *from,
+		EndTime:   *to,
+		Format:    exportFormat,
+	})
+	if err != nil {
+		log.Fatalf("Failed to start export: %v", err)
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			log.Fatalf("Export failed: %v", err)
+		}
+		if _, err := w.Write(chunk.GetData()); err != nil {
+			log.Fatalf("Failed to write export: %v", err)
+		}
+	}
+}
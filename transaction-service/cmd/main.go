@@ -1,16 +1,29 @@
 package main
 
 import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/cache"
 	grpcDelivery "cs2-marketplace-microservices/transaction-service/internal/delivery/grpc"
+	"cs2-marketplace-microservices/transaction-service/internal/fees"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
 	"cs2-marketplace-microservices/transaction-service/internal/repository"
 	repomongo "cs2-marketplace-microservices/transaction-service/internal/repository/mongo"
+	"cs2-marketplace-microservices/transaction-service/internal/risk"
 	"cs2-marketplace-microservices/transaction-service/internal/usecase"
+	"cs2-marketplace-microservices/transaction-service/internal/worker"
+	"cs2-marketplace-microservices/transaction-service/pkg/clients"
 	"cs2-marketplace-microservices/transaction-service/pkg/config"
 	"cs2-marketplace-microservices/transaction-service/pkg/database"
+	"cs2-marketplace-microservices/transaction-service/pkg/email"
+	"cs2-marketplace-microservices/transaction-service/pkg/messaging"
+	"cs2-marketplace-microservices/transaction-service/pkg/metrics"
 	"cs2-marketplace-microservices/transaction-service/proto/transaction"
 	"log"
 	"net"
+	"net/http"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -19,25 +32,161 @@ func main() {
 	// Load configuration
 	cfg := config.LoadConfig()
 
+	// Start metrics server in a separate goroutine
+	go func() {
+		http.Handle("/metrics", promhttp.Handler())
+		http.HandleFunc("/health", healthCheckHandler)
+		log.Printf("Metrics server running on %s", cfg.MetricsPort)
+		if err := http.ListenAndServe(cfg.MetricsPort, nil); err != nil {
+			log.Printf("Metrics server failed: %v", err)
+		}
+	}()
+
 	// Initialize database
 	db, err := database.InitDB(cfg.MongoURI)
 	if err != nil {
+		metrics.ServiceUp.Set(0)
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 	defer database.CloseDB()
 
-	// Initialize repository
+	// Set database connection metric
+	metrics.DatabaseConnections.Set(1)
+
+	// Initialize repositories
 	transactionRepo := repomongo.NewTransactionRepository(db)
-	repositories := repository.NewRepositories(transactionRepo)
+	if err := transactionRepo.EnsureIndexes(context.Background()); err != nil {
+		log.Fatalf("Failed to create indexes: %v", err)
+	}
+	migrated, err := transactionRepo.MigrateMoney(context.Background(), cfg.Currency)
+	if err != nil {
+		log.Fatalf("Failed to migrate transaction amounts: %v", err)
+	}
+	if migrated > 0 {
+		log.Printf("Migrated %d transactions to %s amounts", migrated, cfg.Currency)
+	}
+	disputeRepo := repomongo.NewDisputeRepository(db)
+	if err := disputeRepo.EnsureIndexes(context.Background()); err != nil {
+		log.Fatalf("Failed to create dispute indexes: %v", err)
+	}
+	priceHistoryRepo := repomongo.NewPriceHistoryRepository(db)
+	if err := priceHistoryRepo.EnsureIndexes(context.Background()); err != nil {
+		log.Fatalf("Failed to create price history indexes: %v", err)
+	}
+	orderRepo := repomongo.NewOrderRepository(db)
+	if err := orderRepo.EnsureIndexes(context.Background()); err != nil {
+		log.Fatalf("Failed to create order indexes: %v", err)
+	}
+	receiptRepo := repomongo.NewReceiptRepository(db)
+	if err := receiptRepo.EnsureIndexes(context.Background()); err != nil {
+		log.Fatalf("Failed to create receipt indexes: %v", err)
+	}
+	repositories := repository.NewRepositories(transactionRepo, disputeRepo, priceHistoryRepo, orderRepo, receiptRepo)
+
+	// Initialize clients for the inventory and user services
+	serviceClients, err := clients.New(cfg.InventoryServiceAddr, cfg.UserServiceAddr)
+	if err != nil {
+		log.Fatalf("Failed to create service clients: %v", err)
+	}
+	defer serviceClients.Close()
+
+	// Initialize fee engine
+	var feeTiers []fees.Tier
+	for minAmount, percent := range cfg.FeePriceTiers {
+		feeTiers = append(feeTiers, fees.Tier{MinAmount: minAmount, Percent: percent})
+	}
+	feeEngine := fees.NewEngine(fees.Schedule{
+		Currency:       cfg.Currency,
+		Percent:        cfg.FeePercent,
+		Fixed:          cfg.FeeFixed,
+		Minimum:        cfg.FeeMinimum,
+		RarityPercents: cfg.FeeRarityPercents,
+		Tiers:          feeTiers,
+		ExemptUserIDs:  cfg.FeeExemptUserIDs,
+		ExemptAdmins:   cfg.FeeExemptAdmins,
+	})
+
+	// Initialize risk checks
+	var riskRules []risk.Rule
+	for _, name := range cfg.RiskRules {
+		switch name {
+		case risk.RuleVelocity:
+			riskRules = append(riskRules, risk.Velocity{
+				Window:   cfg.RiskVelocityWindow,
+				Max:      cfg.RiskVelocityMax,
+				Decision: mustParseRiskDecision(name, cfg.RiskVelocityDecision),
+			})
+		case risk.RulePingPong:
+			riskRules = append(riskRules, risk.PingPong{
+				Window:   cfg.RiskPingPongWindow,
+				Max:      cfg.RiskPingPongMax,
+				Decision: mustParseRiskDecision(name, cfg.RiskPingPongDecision),
+			})
+		case risk.RulePriceSpike:
+			riskRules = append(riskRules, risk.PriceSpike{
+				Window:     cfg.RiskPriceSpikeWindow,
+				Multiplier: cfg.RiskPriceSpikeMultiplier,
+				Decision:   mustParseRiskDecision(name, cfg.RiskPriceSpikeDecision),
+			})
+		case risk.RuleNewAccount:
+			riskRules = append(riskRules, risk.NewAccount{
+				MinAge:   cfg.RiskNewAccountAge,
+				Decision: mustParseRiskDecision(name, cfg.RiskNewAccountDecision),
+			})
+		default:
+			log.Fatalf("Unknown risk rule %q", name)
+		}
+	}
+	riskChain := risk.NewChain(riskRules...)
+
+	// Initialize email sender for receipts
+	var emailSender email.Sender = &email.MockSender{}
+	if cfg.SMTPHost != "" {
+		emailSender = email.NewSMTPSender(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.EmailFrom)
+	}
+
+	// Initialize NATS publishing of transaction lifecycle events
+	natsClient, err := messaging.New(cfg.NATSURL)
+	if err != nil {
+		log.Fatalf("NATS connection failed: %v", err)
+	}
+	defer natsClient.Conn.Close()
+	eventPublisher := messaging.NewPublisher(natsClient.Conn, int(cfg.EventQueueSize), int(cfg.EventPublishAttempts), cfg.EventRetryBackoff)
+	metrics.RegisterPublisher(eventPublisher)
+
+	// Create cache with default expiration of 5 minutes and cleanup every 10 minutes
+	transactionCache := cache.New(5*time.Minute, 10*time.Minute)
+	metrics.RegisterCache(transactionCache)
 
 	// Initialize use case
-	transactionUsecase := usecase.NewTransactionUsecase(repositories.Transaction)
+	transactionUsecase := usecase.NewTransactionUsecase(repositories.Transaction, repositories.Dispute, repositories.PriceHistory, repositories.Order, repositories.Receipt, serviceClients.Inventory, serviceClients.User, feeEngine, riskChain, emailSender, eventPublisher, transactionCache, cfg.Currency, cfg.IdempotencyKeyRetention)
+
+	// Start background workers
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	go eventPublisher.Run(workerCtx)
+
+	reaper := worker.NewReaper(transactionUsecase, cfg.PendingTimeout, cfg.TradeOfferTimeout, cfg.ReaperInterval)
+	go reaper.Run(workerCtx)
+
+	archiver := worker.NewArchiver(transactionUsecase, cfg.ArchiveAfter, cfg.ArchiveInterval)
+	go archiver.Run(workerCtx)
+
+	leaderboards := worker.NewLeaderboardRefresher(transactionUsecase, cfg.LeaderboardRefreshInterval)
+	go leaderboards.Run(workerCtx)
+
+	watchFeed := worker.NewWatchFeed(transactionUsecase, cfg.WatchFeedRetry)
+	go watchFeed.Run(workerCtx)
 
 	// Initialize gRPC handler
 	handler := grpcDelivery.NewHandler(transactionUsecase)
 
 	// Create gRPC server
-	server := grpc.NewServer()
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(metrics.UnaryServerInterceptor),
+		grpc.StreamInterceptor(metrics.StreamServerInterceptor),
+	)
 
 	// Register the transaction service
 	transaction.RegisterTransactionServiceServer(server, handler)
@@ -48,15 +197,32 @@ func main() {
 	// Listen on the configured port
 	listener, err := net.Listen("tcp", cfg.ServerPort)
 	if err != nil {
+		metrics.ServiceUp.Set(0)
 		log.Fatalf("Failed to listen on port %s: %v", cfg.ServerPort, err)
 	}
 
 	log.Printf("Transaction service starting on port %s", cfg.ServerPort)
 	log.Printf("Connected to MongoDB: %s", cfg.MongoURI)
 	log.Printf("Database: %s", cfg.DBName)
+	log.Printf("Metrics available at http://localhost%s/metrics", cfg.MetricsPort)
+	log.Printf("Health check available at http://localhost%s/health", cfg.MetricsPort)
 
 	// Start the gRPC server
 	if err := server.Serve(listener); err != nil {
+		metrics.ServiceUp.Set(0)
 		log.Fatalf("Failed to serve gRPC server: %v", err)
 	}
 }
+
+func healthCheckHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("OK"))
+}
+
+func mustParseRiskDecision(rule, decision string) models.RiskDecision {
+	d, err := risk.ParseDecision(decision)
+	if err != nil {
+		log.Fatalf("Invalid decision for risk rule %s: %v", rule, err)
+	}
+	return d
+}
@@ -0,0 +1,90 @@
+// Command reconcile checks every completed transaction against the balance
+// holds in user-service and the skin owners in inventory-service, and writes
+// a JSON report of the discrepancies found. With -repair, an open repair task
+// is recorded for each of them. It exits with status 1 if any were found.
+//
+//	go run ./cmd/reconcile -out report.json -repair
+package main
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/reconcile"
+	repomongo "cs2-marketplace-microservices/transaction-service/internal/repository/mongo"
+	"cs2-marketplace-microservices/transaction-service/pkg/clients"
+	"cs2-marketplace-microservices/transaction-service/pkg/config"
+	"cs2-marketplace-microservices/transaction-service/pkg/database"
+	"encoding/json"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+)
+
+func main() {
+	out := flag.String("out", "", "report file (default stdout)")
+	repair := flag.Bool("repair", false, "open a repair task for each discrepancy")
+	flag.Parse()
+
+	cfg := config.LoadConfig()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	db, err := database.InitDB(cfg.MongoURI)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer database.CloseDB()
+
+	serviceClients, err := clients.New(cfg.InventoryServiceAddr, cfg.UserServiceAddr)
+	if err != nil {
+		log.Fatalf("Failed to create service clients: %v", err)
+	}
+	defer serviceClients.Close()
+
+	reconciler := reconcile.New(repomongo.NewTransactionRepository(db), serviceClients.Inventory, serviceClients.User)
+	report, err := reconciler.Run(ctx)
+	if err != nil {
+		log.Fatalf("Reconciliation failed: %v", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("Failed to create %s: %v", *out, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		log.Fatalf("Failed to write report: %v", err)
+	}
+
+	log.Printf("Checked %d transactions, %d holds and %d skins: %d discrepancies %v",
+		report.TransactionsChecked, report.HoldsChecked, report.SkinsChecked, len(report.Discrepancies), report.Counts())
+
+	if *repair {
+		repairTaskRepo := repomongo.NewRepairTaskRepository(db)
+		if err := repairTaskRepo.EnsureIndexes(ctx); err != nil {
+			log.Fatalf("Failed to create repair task indexes: %v", err)
+		}
+		opened, err := reconcile.OpenRepairTasks(ctx, repairTaskRepo, report)
+		if err != nil {
+			log.Fatalf("Failed to open repair tasks: %v", err)
+		}
+		log.Printf("Opened %d repair tasks", opened)
+	}
+
+	if len(report.Discrepancies) > 0 {
+		// Deferred calls do not run on os.Exit
+		stop()
+		serviceClients.Close()
+		database.CloseDB()
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,162 @@
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+)
+
+// AllTag is the tag of entries that depend on every transaction, such as
+// unfiltered lists and global stats
+const AllTag = "all"
+
+// UserTag is the tag of entries that depend on the transactions of a user
+func UserTag(userID string) string { return "user:" + userID }
+
+// SkinTag is the tag of entries that depend on the transactions of a skin
+func SkinTag(skinID string) string { return "skin:" + skinID }
+
+// StatusTag is the tag of entries that depend on the transactions in a status
+func StatusTag(status string) string { return "status:" + status }
+
+// Stats counts cache lookups and removed entries since the cache was created
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64 // entries removed by expiry, deletion, invalidation or flush
+}
+
+// HitRatio returns the share of lookups that were hits
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// Cache is an in-memory cache whose entries can be registered under tags.
+// Invalidating a tag removes every entry registered under it.
+type Cache struct {
+	store *gocache.Cache
+
+	mu      sync.Mutex
+	tags    map[string]map[string]struct{} // tag -> keys
+	keyTags map[string][]string            // key -> tags
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+func New(defaultExpiration, cleanupInterval time.Duration) *Cache {
+	c := &Cache{
+		store:   gocache.New(defaultExpiration, cleanupInterval),
+		tags:    make(map[string]map[string]struct{}),
+		keyTags: make(map[string][]string),
+	}
+
+	// Called for deleted and expired entries, outside the store's lock
+	c.store.OnEvicted(func(key string, _ interface{}) {
+		c.evictions.Add(1)
+		if _, found := c.store.Get(key); found {
+			// Set again since it was removed, so the tags belong to the new entry
+			return
+		}
+		c.untag(key)
+	})
+
+	return c
+}
+
+// Get returns the entry stored under key
+func (c *Cache) Get(key string) (interface{}, bool) {
+	value, found := c.store.Get(key)
+	if found {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+	return value, found
+}
+
+// Set stores value under key for ttl and registers it under tags, replacing
+// the tags of any previous entry
+func (c *Cache) Set(key string, value interface{}, ttl time.Duration, tags ...string) {
+	c.mu.Lock()
+	c.untagLocked(key)
+	for _, tag := range tags {
+		keys, ok := c.tags[tag]
+		if !ok {
+			keys = make(map[string]struct{})
+			c.tags[tag] = keys
+		}
+		keys[key] = struct{}{}
+	}
+	if len(tags) > 0 {
+		c.keyTags[key] = tags
+	}
+	c.mu.Unlock()
+
+	c.store.Set(key, value, ttl)
+}
+
+// Delete removes the entry stored under key
+func (c *Cache) Delete(key string) {
+	c.store.Delete(key)
+}
+
+// Invalidate removes every entry registered under any of tags
+func (c *Cache) Invalidate(tags ...string) {
+	var keys []string
+
+	c.mu.Lock()
+	for _, tag := range tags {
+		for key := range c.tags[tag] {
+			keys = append(keys, key)
+		}
+	}
+	c.mu.Unlock()
+
+	// Deleting untags the entries through the eviction callback
+	for _, key := range keys {
+		c.store.Delete(key)
+	}
+}
+
+// Flush removes every entry
+func (c *Cache) Flush() {
+	c.evictions.Add(uint64(c.store.ItemCount()))
+	c.store.Flush()
+
+	c.mu.Lock()
+	c.tags = make(map[string]map[string]struct{})
+	c.keyTags = make(map[string][]string)
+	c.mu.Unlock()
+}
+
+// Stats returns the hit, miss and eviction counts
+func (c *Cache) Stats() Stats {
+	return Stats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+	}
+}
+
+func (c *Cache) untag(key string) {
+	c.mu.Lock()
+	c.untagLocked(key)
+	c.mu.Unlock()
+}
+
+func (c *Cache) untagLocked(key string) {
+	for _, tag := range c.keyTags[key] {
+		delete(c.tags[tag], key)
+		if len(c.tags[tag]) == 0 {
+			delete(c.tags, tag)
+		}
+	}
+	delete(c.keyTags, key)
+}
@@ -2,8 +2,14 @@ package grpc
 
 import (
 	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
 	"cs2-marketplace-microservices/transaction-service/internal/usecase"
+	"cs2-marketplace-microservices/transaction-service/internal/watch"
 	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Handler struct {
@@ -15,50 +21,264 @@ func NewHandler(uc usecase.TransactionUsecase) *Handler {
 	return &Handler{uc: uc}
 }
 
+// toStatusError maps domain errors to gRPC status codes
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, models.ErrTransactionNotFound), errors.Is(err, models.ErrDisputeNotFound),
+		errors.Is(err, models.ErrReceiptNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrDisputeExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, models.ErrDisputeNotOpen), errors.Is(err, models.ErrNotDisputable), errors.Is(err, models.ErrItemNotReturnable),
+		errors.Is(err, models.ErrNotDeleted), errors.Is(err, models.ErrAlreadyDeleted):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, models.ErrTradeItemNotOwned), errors.Is(err, models.ErrNotTrade),
+		errors.Is(err, models.ErrNoReceipt):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, models.ErrNotAdmin), errors.Is(err, models.ErrNotTradeParty),
+		errors.Is(err, models.ErrBlockedByRisk):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, models.ErrInvalidStatusTransition), errors.Is(err, models.ErrCompletedByFlow):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, models.ErrVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, models.ErrIdempotencyKeyReused), errors.Is(err, models.ErrInvalidPageToken),
+		errors.Is(err, watch.ErrInvalidResumeToken), errors.Is(err, models.ErrCurrencyMismatch),
+		errors.Is(err, models.ErrInvalidConversion), errors.Is(err, models.ErrAmountOutOfRange):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, watch.ErrResumeTokenExpired):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, watch.ErrSubscriberLagged):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, models.ErrInvalidSession):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, watch.ErrFeedNotStarted):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return err
+	}
+}
+
 func (h *Handler) CreateTransaction(ctx context.Context, req *transaction.CreateTransactionRequest) (*transaction.TransactionResponse, error) {
-	return h.uc.CreateTransaction(ctx, req)
+	resp, err := h.uc.CreateTransaction(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
 }
 
 func (h *Handler) GetTransaction(ctx context.Context, req *transaction.GetTransactionRequest) (*transaction.TransactionResponse, error) {
-	return h.uc.GetTransaction(ctx, req)
+	resp, err := h.uc.GetTransaction(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) GetTransactionHistory(ctx context.Context, req *transaction.GetTransactionRequest) (*transaction.TransactionHistoryResponse, error) {
+	resp, err := h.uc.GetTransactionHistory(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) GetReceipt(ctx context.Context, req *transaction.GetReceiptRequest) (*transaction.ReceiptResponse, error) {
+	resp, err := h.uc.GetReceipt(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
 }
 
 func (h *Handler) UpdateTransaction(ctx context.Context, req *transaction.UpdateTransactionRequest) (*transaction.TransactionResponse, error) {
-	return h.uc.UpdateTransaction(ctx, req)
+	resp, err := h.uc.UpdateTransaction(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
 }
 
 func (h *Handler) DeleteTransaction(ctx context.Context, req *transaction.GetTransactionRequest) (*transaction.DeleteResponse, error) {
-	return h.uc.DeleteTransaction(ctx, req)
+	resp, err := h.uc.DeleteTransaction(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) RestoreTransaction(ctx context.Context, req *transaction.RestoreTransactionRequest) (*transaction.TransactionResponse, error) {
+	resp, err := h.uc.RestoreTransaction(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
 }
 
 func (h *Handler) ListTransactions(ctx context.Context, req *transaction.GetTransactionsByUserRequest) (*transaction.TransactionListResponse, error) {
-	return h.uc.ListTransactions(ctx, req)
+	resp, err := h.uc.ListTransactions(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
 }
 
 func (h *Handler) GetTransactionsByUser(ctx context.Context, req *transaction.GetTransactionsByUserRequest) (*transaction.TransactionListResponse, error) {
-	return h.uc.GetTransactionsByUser(ctx, req)
+	resp, err := h.uc.GetTransactionsByUser(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
 }
 
 func (h *Handler) GetTransactionsBySkin(ctx context.Context, req *transaction.GetTransactionsBySkinRequest) (*transaction.TransactionListResponse, error) {
-	return h.uc.GetTransactionsBySkin(ctx, req)
+	resp, err := h.uc.GetTransactionsBySkin(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
 }
 
 func (h *Handler) GetTransactionsByStatus(ctx context.Context, req *transaction.GetTransactionsByStatusRequest) (*transaction.TransactionListResponse, error) {
-	return h.uc.GetTransactionsByStatus(ctx, req)
+	resp, err := h.uc.GetTransactionsByStatus(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
 }
 
 func (h *Handler) ProcessPurchase(ctx context.Context, req *transaction.ProcessPurchaseRequest) (*transaction.TransactionResponse, error) {
-	return h.uc.ProcessPurchase(ctx, req)
+	resp, err := h.uc.ProcessPurchase(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) ProcessCartPurchase(ctx context.Context, req *transaction.ProcessCartPurchaseRequest) (*transaction.CartPurchaseResponse, error) {
+	resp, err := h.uc.ProcessCartPurchase(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
 }
 
 func (h *Handler) CancelTransaction(ctx context.Context, req *transaction.CancelTransactionRequest) (*transaction.TransactionResponse, error) {
-	return h.uc.CancelTransaction(ctx, req)
+	resp, err := h.uc.CancelTransaction(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) CreateTrade(ctx context.Context, req *transaction.CreateTradeRequest) (*transaction.TransactionResponse, error) {
+	resp, err := h.uc.CreateTrade(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) AcceptTrade(ctx context.Context, req *transaction.AcceptTradeRequest) (*transaction.TransactionResponse, error) {
+	resp, err := h.uc.AcceptTrade(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) WatchTransactions(req *transaction.WatchTransactionsRequest, stream transaction.TransactionService_WatchTransactionsServer) error {
+	err := h.uc.WatchTransactions(stream.Context(), req, stream.Send)
+	if err != nil {
+		return toStatusError(err)
+	}
+	return nil
+}
+
+func (h *Handler) ExportTransactions(req *transaction.ExportTransactionsRequest, stream transaction.TransactionService_ExportTransactionsServer) error {
+	err := h.uc.ExportTransactions(stream.Context(), req, stream.Send)
+	if err != nil {
+		return toStatusError(err)
+	}
+	return nil
 }
 
 func (h *Handler) GetTransactionStats(ctx context.Context, req *transaction.GetTransactionStatsRequest) (*transaction.TransactionStatsResponse, error) {
-	return h.uc.GetTransactionStats(ctx, req)
+	resp, err := h.uc.GetTransactionStats(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) GetTransactionVolumeSeries(ctx context.Context, req *transaction.GetTransactionVolumeSeriesRequest) (*transaction.TransactionVolumeSeriesResponse, error) {
+	resp, err := h.uc.GetTransactionVolumeSeries(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) GetSkinPriceHistory(ctx context.Context, req *transaction.GetSkinPriceHistoryRequest) (*transaction.SkinPriceHistoryResponse, error) {
+	resp, err := h.uc.GetSkinPriceHistory(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) GetLeaderboard(ctx context.Context, req *transaction.GetLeaderboardRequest) (*transaction.LeaderboardResponse, error) {
+	resp, err := h.uc.GetLeaderboard(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
 }
 
 func (h *Handler) GetAllTransactions(ctx context.Context, req *transaction.GetTransactionsByStatusRequest) (*transaction.TransactionListResponse, error) {
-	return h.uc.GetAllTransactions(ctx, req)
+	resp, err := h.uc.GetAllTransactions(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) QuoteFees(ctx context.Context, req *transaction.QuoteFeesRequest) (*transaction.QuoteFeesResponse, error) {
+	resp, err := h.uc.QuoteFees(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) OpenDispute(ctx context.Context, req *transaction.OpenDisputeRequest) (*transaction.DisputeResponse, error) {
+	resp, err := h.uc.OpenDispute(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) GetDispute(ctx context.Context, req *transaction.GetDisputeRequest) (*transaction.DisputeResponse, error) {
+	resp, err := h.uc.GetDispute(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) ListDisputes(ctx context.Context, req *transaction.ListDisputesRequest) (*transaction.DisputeListResponse, error) {
+	resp, err := h.uc.ListDisputes(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+func (h *Handler) ResolveDispute(ctx context.Context, req *transaction.ResolveDisputeRequest) (*transaction.DisputeResponse, error) {
+	resp, err := h.uc.ResolveDispute(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
 }
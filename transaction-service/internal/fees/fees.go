@@ -0,0 +1,170 @@
+package fees
+
+import (
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Tier applies Percent to transactions of at least MinAmount
+type Tier struct {
+	MinAmount float64
+	Percent   float64
+}
+
+// Schedule describes how the marketplace fee is computed.
+// The percentage is picked from the first of RarityPercents, Tiers and Percent
+// that applies; Fixed is added on top and the result is raised to Minimum.
+// Fixed, Minimum and the tier thresholds are in major units of Currency.
+type Schedule struct {
+	Currency       string
+	Percent        float64
+	Fixed          float64
+	Minimum        float64
+	RarityPercents map[string]float64
+	Tiers          []Tier
+	ExemptUserIDs  []string
+	ExemptAdmins   bool
+}
+
+// QuoteInput describes the sale a fee is quoted for. Conversions are needed
+// when Amount is not in the currency of the schedule.
+type QuoteInput struct {
+	Amount        models.Money
+	Conversions   []models.Conversion
+	Rarity        string
+	SellerID      string
+	SellerIsAdmin bool
+}
+
+// Quote is the fee breakdown for a sale, in the currency of the amount
+type Quote struct {
+	Amount         models.Money
+	Percent        float64
+	FixedFee       models.Money
+	FeeAmount      models.Money
+	SellerProceeds models.Money
+	Exempt         bool
+}
+
+type Engine struct {
+	schedule Schedule
+	exempt   map[string]bool
+	rarities map[string]float64
+}
+
+func NewEngine(schedule Schedule) *Engine {
+	exempt := make(map[string]bool, len(schedule.ExemptUserIDs))
+	for _, id := range schedule.ExemptUserIDs {
+		exempt[id] = true
+	}
+
+	// Rarities are matched case-insensitively
+	rarities := make(map[string]float64, len(schedule.RarityPercents))
+	for rarity, percent := range schedule.RarityPercents {
+		rarities[strings.ToLower(rarity)] = percent
+	}
+
+	// Highest threshold first so the first match is the most specific tier
+	tiers := append([]Tier(nil), schedule.Tiers...)
+	sort.Slice(tiers, func(i, j int) bool { return tiers[i].MinAmount > tiers[j].MinAmount })
+	schedule.Tiers = tiers
+
+	return &Engine{
+		schedule: schedule,
+		exempt:   exempt,
+		rarities: rarities,
+	}
+}
+
+// ChecksAdmins reports whether quotes depend on QuoteInput.SellerIsAdmin
+func (e *Engine) ChecksAdmins() bool {
+	return e.schedule.ExemptAdmins
+}
+
+// UsesRarity reports whether quotes depend on QuoteInput.Rarity
+func (e *Engine) UsesRarity() bool {
+	return len(e.rarities) > 0
+}
+
+// Quote computes the fee and the seller proceeds for a sale. It fails with
+// ErrCurrencyMismatch if the schedule's amounts are needed in another currency
+// and no conversion was given.
+func (e *Engine) Quote(in QuoteInput) (Quote, error) {
+	currency := in.Amount.Currency
+	quote := Quote{
+		Amount:         in.Amount,
+		FixedFee:       models.Money{Currency: currency},
+		FeeAmount:      models.Money{Currency: currency},
+		SellerProceeds: in.Amount,
+	}
+
+	if e.exempt[in.SellerID] || (e.schedule.ExemptAdmins && in.SellerIsAdmin) {
+		quote.Exempt = true
+		return quote, nil
+	}
+
+	percent, err := e.percentFor(in)
+	if err != nil {
+		return Quote{}, err
+	}
+	fixed, err := e.scheduleAmount(e.schedule.Fixed, currency, in.Conversions)
+	if err != nil {
+		return Quote{}, err
+	}
+	minimum, err := e.scheduleAmount(e.schedule.Minimum, currency, in.Conversions)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	quote.Percent = percent
+	quote.FixedFee = fixed
+
+	fee := int64(math.Round(float64(in.Amount.Units)*percent/100)) + fixed.Units
+	fee = max(fee, minimum.Units)
+	fee = min(fee, in.Amount.Units)
+
+	quote.FeeAmount = models.Money{Units: fee, Currency: currency}
+	quote.SellerProceeds = models.Money{Units: in.Amount.Units - fee, Currency: currency}
+	return quote, nil
+}
+
+func (e *Engine) percentFor(in QuoteInput) (float64, error) {
+	if percent, ok := e.rarities[strings.ToLower(in.Rarity)]; ok {
+		return percent, nil
+	}
+
+	if len(e.schedule.Tiers) == 0 {
+		return e.schedule.Percent, nil
+	}
+
+	// Tier thresholds are in the currency of the schedule
+	amount, err := models.Convert(in.Amount, e.schedule.Currency, in.Conversions)
+	if err != nil {
+		return 0, err
+	}
+	major := models.MajorUnits(float64(amount.Units), amount.Currency)
+
+	for _, tier := range e.schedule.Tiers {
+		if major >= tier.MinAmount {
+			return tier.Percent, nil
+		}
+	}
+
+	return e.schedule.Percent, nil
+}
+
+// scheduleAmount converts an amount of the schedule, in major units, to currency
+func (e *Engine) scheduleAmount(major float64, currency string, conversions []models.Conversion) (models.Money, error) {
+	if major == 0 {
+		return models.Money{Currency: currency}, nil
+	}
+
+	digits := models.MinorUnitDigits(e.schedule.Currency)
+	amount := models.Money{
+		Units:    int64(math.Round(major * math.Pow10(digits))),
+		Currency: e.schedule.Currency,
+	}
+	return models.Convert(amount, currency, conversions)
+}
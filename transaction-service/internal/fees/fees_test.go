@@ -0,0 +1,169 @@
+package fees
+
+import (
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"errors"
+	"testing"
+)
+
+func usd(units int64) models.Money {
+	return models.Money{Units: units, Currency: "USD"}
+}
+
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		name         string
+		schedule     Schedule
+		in           QuoteInput
+		wantPercent  float64
+		wantFee      int64
+		wantProceeds int64
+		wantExempt   bool
+	}{
+		{
+			name:        "percent",
+			schedule:    Schedule{Currency: "USD", Percent: 5},
+			in:          QuoteInput{Amount: usd(10000)},
+			wantPercent: 5, wantFee: 500, wantProceeds: 9500,
+		},
+		{
+			name:        "percent rounds to the nearest minor unit",
+			schedule:    Schedule{Currency: "USD", Percent: 2.5},
+			in:          QuoteInput{Amount: usd(1001)},
+			wantPercent: 2.5, wantFee: 25, wantProceeds: 976,
+		},
+		{
+			name:        "fixed fee on top",
+			schedule:    Schedule{Currency: "USD", Percent: 5, Fixed: 0.30},
+			in:          QuoteInput{Amount: usd(10000)},
+			wantPercent: 5, wantFee: 530, wantProceeds: 9470,
+		},
+		{
+			name:        "raised to the minimum",
+			schedule:    Schedule{Currency: "USD", Percent: 5, Minimum: 1},
+			in:          QuoteInput{Amount: usd(500)},
+			wantPercent: 5, wantFee: 100, wantProceeds: 400,
+		},
+		{
+			name:        "capped at the amount",
+			schedule:    Schedule{Currency: "USD", Percent: 5, Minimum: 1},
+			in:          QuoteInput{Amount: usd(50)},
+			wantPercent: 5, wantFee: 50, wantProceeds: 0,
+		},
+		{
+			name:        "rarity override is case-insensitive",
+			schedule:    Schedule{Currency: "USD", Percent: 5, RarityPercents: map[string]float64{"Covert": 2}},
+			in:          QuoteInput{Amount: usd(10000), Rarity: "COVERT"},
+			wantPercent: 2, wantFee: 200, wantProceeds: 9800,
+		},
+		{
+			name: "rarity override wins over tiers",
+			schedule: Schedule{
+				Currency:       "USD",
+				Percent:        5,
+				RarityPercents: map[string]float64{"covert": 2},
+				Tiers:          []Tier{{MinAmount: 10, Percent: 4}},
+			},
+			in:          QuoteInput{Amount: usd(10000), Rarity: "Covert"},
+			wantPercent: 2, wantFee: 200, wantProceeds: 9800,
+		},
+		{
+			name: "highest matching tier",
+			schedule: Schedule{
+				Currency: "USD",
+				Percent:  5,
+				Tiers:    []Tier{{MinAmount: 10, Percent: 4}, {MinAmount: 1000, Percent: 2}, {MinAmount: 100, Percent: 3}},
+			},
+			in:          QuoteInput{Amount: usd(50000)},
+			wantPercent: 3, wantFee: 1500, wantProceeds: 48500,
+		},
+		{
+			name:        "below every tier",
+			schedule:    Schedule{Currency: "USD", Percent: 5, Tiers: []Tier{{MinAmount: 100, Percent: 3}}},
+			in:          QuoteInput{Amount: usd(5000)},
+			wantPercent: 5, wantFee: 250, wantProceeds: 4750,
+		},
+		{
+			name:     "tier threshold in the schedule currency",
+			schedule: Schedule{Currency: "USD", Percent: 5, Tiers: []Tier{{MinAmount: 100, Percent: 3}}},
+			in: QuoteInput{
+				Amount:      models.Money{Units: 10000, Currency: "EUR"},
+				Conversions: []models.Conversion{{From: "USD", To: "EUR", Rate: "0.9"}},
+			},
+			wantPercent: 3, wantFee: 300, wantProceeds: 9700,
+		},
+		{
+			name:     "fixed fee converted",
+			schedule: Schedule{Currency: "USD", Fixed: 1},
+			in: QuoteInput{
+				Amount:      models.Money{Units: 10000, Currency: "JPY"},
+				Conversions: []models.Conversion{{From: "USD", To: "JPY", Rate: "150"}},
+			},
+			wantFee: 150, wantProceeds: 9850,
+		},
+		{
+			name:         "exempt seller",
+			schedule:     Schedule{Currency: "USD", Percent: 5, Minimum: 1, ExemptUserIDs: []string{"seller"}},
+			in:           QuoteInput{Amount: usd(10000), SellerID: "seller"},
+			wantProceeds: 10000, wantExempt: true,
+		},
+		{
+			name:         "exempt admin",
+			schedule:     Schedule{Currency: "USD", Percent: 5, ExemptAdmins: true},
+			in:           QuoteInput{Amount: usd(10000), SellerIsAdmin: true},
+			wantProceeds: 10000, wantExempt: true,
+		},
+		{
+			name:        "admins charged unless exempted",
+			schedule:    Schedule{Currency: "USD", Percent: 5},
+			in:          QuoteInput{Amount: usd(10000), SellerIsAdmin: true},
+			wantPercent: 5, wantFee: 500, wantProceeds: 9500,
+		},
+	}
+
+	for _, tt := range tests {
+		quote, err := NewEngine(tt.schedule).Quote(tt.in)
+		if err != nil {
+			t.Errorf("%s: Quote: %v", tt.name, err)
+			continue
+		}
+
+		currency := tt.in.Amount.Currency
+		if quote.Percent != tt.wantPercent {
+			t.Errorf("%s: Percent = %v, want %v", tt.name, quote.Percent, tt.wantPercent)
+		}
+		if want := (models.Money{Units: tt.wantFee, Currency: currency}); quote.FeeAmount != want {
+			t.Errorf("%s: FeeAmount = %v, want %v", tt.name, quote.FeeAmount, want)
+		}
+		if want := (models.Money{Units: tt.wantProceeds, Currency: currency}); quote.SellerProceeds != want {
+			t.Errorf("%s: SellerProceeds = %v, want %v", tt.name, quote.SellerProceeds, want)
+		}
+		if quote.Exempt != tt.wantExempt {
+			t.Errorf("%s: Exempt = %v, want %v", tt.name, quote.Exempt, tt.wantExempt)
+		}
+	}
+}
+
+func TestQuoteMissingConversion(t *testing.T) {
+	eur := models.Money{Units: 10000, Currency: "EUR"}
+
+	schedules := map[string]Schedule{
+		"tiers":   {Currency: "USD", Percent: 5, Tiers: []Tier{{MinAmount: 100, Percent: 3}}},
+		"fixed":   {Currency: "USD", Percent: 5, Fixed: 0.30},
+		"minimum": {Currency: "USD", Percent: 5, Minimum: 1},
+	}
+	for name, schedule := range schedules {
+		if _, err := NewEngine(schedule).Quote(QuoteInput{Amount: eur}); !errors.Is(err, models.ErrCurrencyMismatch) {
+			t.Errorf("%s: Quote error = %v, want ErrCurrencyMismatch", name, err)
+		}
+	}
+
+	// A plain percentage needs no conversion
+	quote, err := NewEngine(Schedule{Currency: "USD", Percent: 5}).Quote(QuoteInput{Amount: eur})
+	if err != nil {
+		t.Fatalf("Quote: %v", err)
+	}
+	if want := (models.Money{Units: 500, Currency: "EUR"}); quote.FeeAmount != want {
+		t.Errorf("FeeAmount = %v, want %v", quote.FeeAmount, want)
+	}
+}
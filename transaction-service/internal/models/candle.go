@@ -0,0 +1,111 @@
+package models
+
+import (
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"time"
+)
+
+// CandleInterval is the length of the time buckets price candles cover
+type CandleInterval string
+
+const (
+	CandleHour  CandleInterval = "hour"
+	CandleDay   CandleInterval = "day"
+	CandleWeek  CandleInterval = "week" // weeks start on Monday
+	CandleMonth CandleInterval = "month"
+)
+
+// CandleIntervals are the intervals candles are pre-aggregated for
+var CandleIntervals = []CandleInterval{CandleHour, CandleDay, CandleWeek, CandleMonth}
+
+// CandleIntervalFromProto maps a bucket interval to a candle interval
+func CandleIntervalFromProto(interval transaction.BucketInterval) (CandleInterval, bool) {
+	switch interval {
+	case transaction.BucketInterval_HOUR:
+		return CandleHour, true
+	case transaction.BucketInterval_DAY:
+		return CandleDay, true
+	case transaction.BucketInterval_WEEK:
+		return CandleWeek, true
+	case transaction.BucketInterval_MONTH:
+		return CandleMonth, true
+	default:
+		return "", false
+	}
+}
+
+// Start returns the start of the UTC bucket containing t
+func (i CandleInterval) Start(t time.Time) time.Time {
+	t = t.UTC()
+	switch i {
+	case CandleHour:
+		return t.Truncate(time.Hour)
+	case CandleWeek:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -((int(t.Weekday()) + 6) % 7))
+	case CandleMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Sale is a completed sale of a skin, as recorded in the price history
+type Sale struct {
+	SkinName  string
+	Condition string
+	Price     Money
+	At        time.Time
+}
+
+// Candle summarizes the sales of a skin name and condition within one bucket.
+// Prices are in minor units of Currency; OpenAt and CloseAt are the times of
+// the first and last sale so sales recorded out of order still land correctly.
+type Candle struct {
+	SkinName  string         `bson:"skin_name"`
+	Condition string         `bson:"condition"`
+	Currency  string         `bson:"currency"`
+	Interval  CandleInterval `bson:"interval"`
+	Start     time.Time      `bson:"start"`
+	Open      int64          `bson:"open"`
+	High      int64          `bson:"high"`
+	Low       int64          `bson:"low"`
+	Close     int64          `bson:"close"`
+	Volume    int64          `bson:"volume"`
+	Sales     int64          `bson:"sales"`
+	OpenAt    time.Time      `bson:"open_at"`
+	CloseAt   time.Time      `bson:"close_at"`
+}
+
+// Add folds a sale into the candle, the same way the repository folds it into
+// a stored candle
+func (c *Candle) Add(sale Sale) {
+	price := sale.Price.Units
+	if c.Sales == 0 || sale.At.Before(c.OpenAt) {
+		c.Open, c.OpenAt = price, sale.At
+	}
+	if c.Sales == 0 || !sale.At.Before(c.CloseAt) {
+		c.Close, c.CloseAt = price, sale.At
+	}
+	if c.Sales == 0 || price > c.High {
+		c.High = price
+	}
+	if c.Sales == 0 || price < c.Low {
+		c.Low = price
+	}
+	c.Volume += price
+	c.Sales++
+}
+
+// Converts MongoDB model to Protobuf message
+func (c *Candle) ToProto() *transaction.PriceCandle {
+	return &transaction.PriceCandle{
+		BucketStart: c.Start.UTC().Format(time.RFC3339),
+		Open:        Money{Units: c.Open, Currency: c.Currency}.ToProto(),
+		High:        Money{Units: c.High, Currency: c.Currency}.ToProto(),
+		Low:         Money{Units: c.Low, Currency: c.Currency}.ToProto(),
+		Close:       Money{Units: c.Close, Currency: c.Currency}.ToProto(),
+		Volume:      Money{Units: c.Volume, Currency: c.Currency}.ToProto(),
+		Sales:       c.Sales,
+	}
+}
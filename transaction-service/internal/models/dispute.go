@@ -0,0 +1,82 @@
+package models
+
+import (
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type DisputeStatus string
+
+const (
+	DisputeOpen     DisputeStatus = "OPEN"
+	DisputeRefunded DisputeStatus = "REFUNDED" // resolved in the buyer's favor
+	DisputeRejected DisputeStatus = "REJECTED" // resolved in the seller's favor
+)
+
+// Dispute is a buyer's claim against a completed transaction
+type Dispute struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	TransactionID  primitive.ObjectID `bson:"transaction_id"`
+	BuyerID        primitive.ObjectID `bson:"buyer_id"`
+	SellerID       primitive.ObjectID `bson:"seller_id"`
+	Reason         string             `bson:"reason"`
+	Evidence       string             `bson:"evidence"`
+	Status         DisputeStatus      `bson:"status"`
+	ResolvedBy     primitive.ObjectID `bson:"resolved_by,omitempty"`
+	ResolutionNote string             `bson:"resolution_note,omitempty"`
+	ReturnItem     bool               `bson:"return_item"`
+	RefundID       primitive.ObjectID `bson:"refund_transaction_id,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	UpdatedAt      time.Time          `bson:"updated_at"`
+	ResolvedAt     time.Time          `bson:"resolved_at,omitempty"`
+}
+
+// Converts MongoDB model to Protobuf message
+func (d *Dispute) ToProto() *transaction.Dispute {
+	p := &transaction.Dispute{
+		Id:             d.ID.Hex(),
+		TransactionId:  d.TransactionID.Hex(),
+		BuyerId:        d.BuyerID.Hex(),
+		SellerId:       d.SellerID.Hex(),
+		Reason:         d.Reason,
+		Evidence:       d.Evidence,
+		Status:         DisputeStatusToProto(d.Status),
+		ResolutionNote: d.ResolutionNote,
+		ReturnItem:     d.ReturnItem,
+		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+	}
+	if !d.ResolvedBy.IsZero() {
+		p.ResolvedBy = d.ResolvedBy.Hex()
+	}
+	if !d.RefundID.IsZero() {
+		p.RefundTransactionId = d.RefundID.Hex()
+	}
+	if !d.ResolvedAt.IsZero() {
+		p.ResolvedAt = d.ResolvedAt.Format(time.RFC3339)
+	}
+	return p
+}
+
+func DisputeStatusToProto(status DisputeStatus) transaction.DisputeStatus {
+	switch status {
+	case DisputeRefunded:
+		return transaction.DisputeStatus_DISPUTE_REFUNDED
+	case DisputeRejected:
+		return transaction.DisputeStatus_DISPUTE_REJECTED
+	default:
+		return transaction.DisputeStatus_DISPUTE_OPEN
+	}
+}
+
+func DisputeStatusFromProto(status transaction.DisputeStatus) DisputeStatus {
+	switch status {
+	case transaction.DisputeStatus_DISPUTE_REFUNDED:
+		return DisputeRefunded
+	case transaction.DisputeStatus_DISPUTE_REJECTED:
+		return DisputeRejected
+	default:
+		return DisputeOpen
+	}
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"cs2-marketplace-microservices/shared/money"
+	"errors"
+)
+
+var (
+	ErrTransactionNotFound     = errors.New("transaction not found")
+	ErrInvalidStatusTransition = errors.New("invalid status transition")
+	ErrCompletedByFlow         = errors.New("purchases, cart orders and trades are only completed by their own flow")
+	ErrVersionConflict         = errors.New("transaction was modified concurrently")
+	ErrDuplicateIdempotencyKey = errors.New("idempotency key already exists")
+	ErrIdempotencyKeyReused    = errors.New("idempotency key was already used with different parameters")
+	ErrInvalidPageToken        = errors.New("invalid page token")
+	ErrDisputeNotFound         = errors.New("dispute not found")
+	ErrDisputeExists           = errors.New("transaction already has an open dispute")
+	ErrDisputeNotOpen          = errors.New("dispute is not open")
+	ErrNotDisputable           = errors.New("transaction cannot be disputed")
+	ErrItemNotReturnable       = errors.New("skin is no longer owned by the buyer and cannot be returned")
+	ErrNotAdmin                = errors.New("admin privileges required")
+	ErrInvalidSession          = errors.New("invalid or expired session")
+	ErrNotDeleted              = errors.New("transaction is not deleted")
+	ErrAlreadyDeleted          = errors.New("transaction is already deleted")
+	ErrCurrencyMismatch        = money.ErrCurrencyMismatch
+	ErrInvalidConversion       = money.ErrInvalidConversion
+	ErrAmountOutOfRange        = money.ErrOutOfRange
+	ErrNotTrade                = errors.New("transaction is not a trade")
+	ErrNotTradeParty           = errors.New("only the other side of a trade can accept it")
+	ErrTradeItemNotOwned       = errors.New("trade item is no longer owned by the expected party")
+	ErrBlockedByRisk           = errors.New("transaction was blocked by risk checks")
+	ErrReceiptNotFound         = errors.New("receipt not found")
+	ErrDuplicateReceipt        = errors.New("transaction already has a receipt")
+	ErrNoReceipt               = errors.New("only completed purchases have a receipt")
+	ErrChangeHistoryLost       = errors.New("changes after the resume token are no longer available")
+)
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"time"
+)
+
+// SystemActor is the actor of changes made by the service itself
+const SystemActor = "system"
+
+// StatusChange is an entry of a transaction's history. Entries are only ever
+// appended; From is empty for the entry recording the creation.
+type StatusChange struct {
+	From    TransactionStatus `bson:"from,omitempty"`
+	To      TransactionStatus `bson:"to"`
+	ActorID string            `bson:"actor_id"`
+	Reason  string            `bson:"reason,omitempty"`
+	At      time.Time         `bson:"at"`
+}
+
+func NewStatusChange(from, to TransactionStatus, actorID, reason string) StatusChange {
+	if actorID == "" {
+		actorID = SystemActor
+	}
+	return StatusChange{
+		From:    from,
+		To:      to,
+		ActorID: actorID,
+		Reason:  reason,
+		At:      time.Now(),
+	}
+}
+
+// Converts MongoDB model to Protobuf message
+func (c *StatusChange) ToProto() *transaction.StatusChange {
+	p := &transaction.StatusChange{
+		ToStatus:  StatusToProto(c.To),
+		ActorId:   c.ActorID,
+		Reason:    c.Reason,
+		Timestamp: c.At.Format(time.RFC3339),
+	}
+	if c.From != "" {
+		from := StatusToProto(c.From)
+		p.FromStatus = &from
+	}
+	return p
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// LeaderboardMetric is what traders are ranked by
+type LeaderboardMetric string
+
+const (
+	MetricVolume       LeaderboardMetric = "volume"
+	MetricTransactions LeaderboardMetric = "transactions"
+	MetricProfit       LeaderboardMetric = "profit"
+)
+
+// LeaderboardMetrics are all metrics, refreshed together
+var LeaderboardMetrics = []LeaderboardMetric{MetricVolume, MetricTransactions, MetricProfit}
+
+func LeaderboardMetricFromProto(metric transaction.LeaderboardMetric) (LeaderboardMetric, bool) {
+	switch metric {
+	case transaction.LeaderboardMetric_LEADERBOARD_VOLUME:
+		return MetricVolume, true
+	case transaction.LeaderboardMetric_LEADERBOARD_TRANSACTIONS:
+		return MetricTransactions, true
+	case transaction.LeaderboardMetric_LEADERBOARD_PROFIT:
+		return MetricProfit, true
+	default:
+		return "", false
+	}
+}
+
+// LeaderboardWindow is the period of trading a leaderboard covers
+type LeaderboardWindow string
+
+const (
+	WindowDay     LeaderboardWindow = "day"
+	WindowWeek    LeaderboardWindow = "week"
+	WindowMonth   LeaderboardWindow = "month"
+	WindowAllTime LeaderboardWindow = "all_time"
+)
+
+// LeaderboardWindows are all windows, refreshed together
+var LeaderboardWindows = []LeaderboardWindow{WindowDay, WindowWeek, WindowMonth, WindowAllTime}
+
+func LeaderboardWindowFromProto(window transaction.LeaderboardWindow) (LeaderboardWindow, bool) {
+	switch window {
+	case transaction.LeaderboardWindow_WINDOW_DAY:
+		return WindowDay, true
+	case transaction.LeaderboardWindow_WINDOW_WEEK:
+		return WindowWeek, true
+	case transaction.LeaderboardWindow_WINDOW_MONTH:
+		return WindowMonth, true
+	case transaction.LeaderboardWindow_WINDOW_ALL_TIME:
+		return WindowAllTime, true
+	default:
+		return "", false
+	}
+}
+
+// Since returns the start of the rolling window ending at now, or nil for all time
+func (w LeaderboardWindow) Since(now time.Time) *time.Time {
+	var since time.Time
+	switch w {
+	case WindowDay:
+		since = now.Add(-24 * time.Hour)
+	case WindowWeek:
+		since = now.AddDate(0, 0, -7)
+	case WindowMonth:
+		since = now.AddDate(0, 0, -30)
+	default:
+		return nil
+	}
+	return &since
+}
+
+// TraderStats is a user's completed trading, with amounts in minor units
+type TraderStats struct {
+	UserID       primitive.ObjectID `bson:"_id"`
+	Volume       int64              `bson:"volume"`
+	Transactions int64              `bson:"transactions"`
+	Profit       int64              `bson:"profit"`
+}
@@ -18,37 +18,187 @@ const (
 )
 
 const (
-	TypeBuy  TransactionType = "BUY"
-	TypeSell TransactionType = "SELL"
+	TypeBuy    TransactionType = "BUY"
+	TypeSell   TransactionType = "SELL"
+	TypeRefund TransactionType = "REFUND"
+	TypeTrade  TransactionType = "TRADE"
 )
 
+// TransactionOrigin records which flow created a transaction
+type TransactionOrigin string
+
+// OriginPurchase marks transactions of ProcessPurchase, whose skin may already
+// have moved to the buyer while the transaction is PENDING
+const OriginPurchase TransactionOrigin = "purchase"
+
+// statusTransitions lists the statuses a transaction may move to from each status.
+// Statuses without an entry are terminal and can no longer change.
+var statusTransitions = map[TransactionStatus][]TransactionStatus{
+	StatusPending: {StatusCompleted, StatusFailed, StatusCancelled},
+}
+
+// CanTransitionTo reports whether a transaction in status s may move to next
+func (s TransactionStatus) CanTransitionTo(next TransactionStatus) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// TerminalStatuses lists the statuses a transaction can no longer leave
+var TerminalStatuses = []TransactionStatus{StatusCompleted, StatusFailed, StatusCancelled}
+
+// IsTerminal reports whether s is a final status
+func (s TransactionStatus) IsTerminal() bool {
+	return len(statusTransitions[s]) == 0
+}
+
 type Transaction struct {
 	ID          primitive.ObjectID `bson:"_id,omitempty"`
 	BuyerID     primitive.ObjectID `bson:"buyer_id"`
 	SellerID    primitive.ObjectID `bson:"seller_id"`
 	SkinID      primitive.ObjectID `bson:"skin_id"`
-	Amount      float64            `bson:"amount"`
+	Rarity      string             `bson:"rarity,omitempty"` // of the skin when the transaction was created
+	Amount      Money              `bson:"amount"`
 	Date        string             `bson:"date"`
 	Status      TransactionStatus  `bson:"status"`
 	Type        TransactionType    `bson:"type"`
 	Description string             `bson:"description"`
+	Version     int64              `bson:"version"`
+	HoldID      string             `bson:"hold_id,omitempty"`
+	Origin      TransactionOrigin  `bson:"origin,omitempty"`
 	CreatedAt   time.Time          `bson:"created_at"`
 	UpdatedAt   time.Time          `bson:"updated_at"`
+
+	// Soft delete: deleted transactions are kept but hidden from lists and stats
+	DeletedAt time.Time `bson:"deleted_at,omitempty"`
+	DeletedBy string    `bson:"deleted_by,omitempty"`
+
+	// Set on transactions moved to the archive collection
+	Archived bool `bson:"archived,omitempty"`
+
+	// Append-only record of every change, oldest first
+	History []StatusChange `bson:"history,omitempty"`
+
+	// Marketplace fee withheld from the amount and what is left for the seller
+	FeeAmount      Money `bson:"fee_amount"`
+	SellerProceeds Money `bson:"seller_proceeds"`
+
+	// Exchange rates supplied with the request, used whenever an amount is
+	// settled with a balance or fee schedule in another currency
+	Conversions []Conversion `bson:"conversions,omitempty"`
+
+	// Links between a refunded transaction and its refund. A refund reverses
+	// the original: its fee and seller proceeds are negative.
+	RefundOfID          primitive.ObjectID `bson:"refund_of_id,omitempty"`
+	RefundTransactionID primitive.ObjectID `bson:"refund_transaction_id,omitempty"`
+	DisputeID           primitive.ObjectID `bson:"dispute_id,omitempty"`
+
+	// Skins swapped by a trade and the side that offered it. The amount of a
+	// trade is the buyer's optional top-up; trades have no single skin.
+	BuyerSkinIDs  []primitive.ObjectID `bson:"buyer_skin_ids,omitempty"`
+	SellerSkinIDs []primitive.ObjectID `bson:"seller_skin_ids,omitempty"`
+	ProposerID    primitive.ObjectID   `bson:"proposer_id,omitempty"`
+
+	// Parent order of a transaction bought in a cart purchase. Its funds are
+	// held and captured by the order, so the transaction has no hold of its own.
+	OrderID primitive.ObjectID `bson:"order_id,omitempty"`
+
+	// Outcome of the risk checks run before the transaction was created
+	Risk *RiskAssessment `bson:"risk,omitempty"`
+
+	// Idempotency key of the request that created the transaction, with a
+	// fingerprint of its parameters; the key is released once it expires
+	IdempotencyKey       string    `bson:"idempotency_key,omitempty"`
+	RequestHash          string    `bson:"request_hash,omitempty"`
+	IdempotencyExpiresAt time.Time `bson:"idempotency_expires_at,omitempty"`
+}
+
+// CompletedByFlow reports whether only the flow that created t may complete
+// it: the purchase saga, a cart checkout or AcceptTrade. These move skins as
+// part of completing, which a plain status update would skip.
+func (t *Transaction) CompletedByFlow() bool {
+	return t.Origin == OriginPurchase || !t.OrderID.IsZero() || t.Type == TypeTrade
+}
+
+// Proceeds returns the part of the amount paid out to the seller.
+// Transactions recorded before fees were introduced pay out the whole amount.
+func (t *Transaction) Proceeds() Money {
+	if t.FeeAmount.Units == 0 && t.SellerProceeds.Units == 0 {
+		return t.Amount
+	}
+	return Money{Units: t.SellerProceeds.Units, Currency: t.Amount.Currency}
+}
+
+// Fee returns the marketplace fee withheld from the amount
+func (t *Transaction) Fee() Money {
+	return Money{Units: t.FeeAmount.Units, Currency: t.Amount.Currency}
+}
+
+// CompletedAt returns when t entered COMPLETED, or its last update if the
+// history does not say
+func (t *Transaction) CompletedAt() time.Time {
+	for i := len(t.History) - 1; i >= 0; i-- {
+		if t.History[i].To == StatusCompleted {
+			return t.History[i].At
+		}
+	}
+	return t.UpdatedAt
+}
+
+// IsDeleted reports whether the transaction has been soft deleted
+func (t *Transaction) IsDeleted() bool {
+	return !t.DeletedAt.IsZero()
 }
 
 // Converts MongoDB model to Protobuf message
 func (t *Transaction) ToProto() *transaction.Transaction {
-	return &transaction.Transaction{
+	p := &transaction.Transaction{
 		Id:          t.ID.Hex(),
 		BuyerId:     t.BuyerID.Hex(),
 		SellerId:    t.SellerID.Hex(),
 		SkinId:      t.SkinID.Hex(),
-		Amount:      t.Amount,
+		Amount:      t.Amount.ToProto(),
 		Date:        t.Date,
 		Status:      protoStatusFromString(string(t.Status)),
 		Type:        protoTypeFromString(string(t.Type)),
 		Description: t.Description,
+		Version:     t.Version,
+		HoldId:      t.HoldID,
+
+		FeeAmount:      t.Fee().ToProto(),
+		SellerProceeds: t.Proceeds().ToProto(),
+		Conversions:    ConversionsToProto(t.Conversions),
+	}
+	if !t.RefundOfID.IsZero() {
+		p.RefundOfId = t.RefundOfID.Hex()
+	}
+	if !t.RefundTransactionID.IsZero() {
+		p.RefundTransactionId = t.RefundTransactionID.Hex()
+	}
+	if !t.DisputeID.IsZero() {
+		p.DisputeId = t.DisputeID.Hex()
 	}
+	if !t.OrderID.IsZero() {
+		p.OrderId = t.OrderID.Hex()
+	}
+	if t.Risk != nil {
+		p.Risk = t.Risk.ToProto()
+	}
+	if t.Type == TypeTrade {
+		p.SkinId = ""
+		p.BuyerSkinIds = hexIDs(t.BuyerSkinIDs)
+		p.SellerSkinIds = hexIDs(t.SellerSkinIDs)
+		p.ProposerId = t.ProposerID.Hex()
+	}
+	if !t.DeletedAt.IsZero() {
+		p.DeletedAt = t.DeletedAt.Format(time.RFC3339)
+		p.DeletedBy = t.DeletedBy
+	}
+	p.Archived = t.Archived
+	return p
 }
 
 // Converts Protobuf message to MongoDB model
@@ -73,26 +223,91 @@ func TransactionFromProto(p *transaction.Transaction) (*Transaction, error) {
 		return nil, err
 	}
 
-	skinID, err := primitive.ObjectIDFromHex(p.GetSkinId())
+	var skinID primitive.ObjectID
+	if p.GetType() != transaction.TransactionType_TRADE {
+		skinID, err = primitive.ObjectIDFromHex(p.GetSkinId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	buyerSkinIDs, err := ObjectIDsFromHex(p.GetBuyerSkinIds())
 	if err != nil {
 		return nil, err
 	}
 
+	sellerSkinIDs, err := ObjectIDsFromHex(p.GetSellerSkinIds())
+	if err != nil {
+		return nil, err
+	}
+
+	var proposerID primitive.ObjectID
+	if p.GetProposerId() != "" {
+		proposerID, err = primitive.ObjectIDFromHex(p.GetProposerId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var orderID primitive.ObjectID
+	if p.GetOrderId() != "" {
+		orderID, err = primitive.ObjectIDFromHex(p.GetOrderId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &Transaction{
 		ID:          objID,
 		BuyerID:     buyerID,
 		SellerID:    sellerID,
 		SkinID:      skinID,
-		Amount:      p.GetAmount(),
+		Amount:      MoneyFromProto(p.GetAmount()),
 		Date:        p.GetDate(),
 		Status:      TransactionStatus(p.GetStatus().String()),
 		Type:        TransactionType(p.GetType().String()),
 		Description: p.GetDescription(),
+		Version:     p.GetVersion(),
+		HoldID:      p.GetHoldId(),
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
+
+		FeeAmount:      MoneyFromProto(p.GetFeeAmount()),
+		SellerProceeds: MoneyFromProto(p.GetSellerProceeds()),
+		Conversions:    ConversionsFromProto(p.GetConversions()),
+
+		BuyerSkinIDs:  buyerSkinIDs,
+		SellerSkinIDs: sellerSkinIDs,
+		ProposerID:    proposerID,
+		OrderID:       orderID,
 	}, nil
 }
 
+// ObjectIDsFromHex parses a list of hex IDs
+func ObjectIDsFromHex(hexes []string) ([]primitive.ObjectID, error) {
+	if len(hexes) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(hexes))
+	for _, h := range hexes {
+		id, err := primitive.ObjectIDFromHex(h)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func hexIDs(ids []primitive.ObjectID) []string {
+	hexes := make([]string, 0, len(ids))
+	for _, id := range ids {
+		hexes = append(hexes, id.Hex())
+	}
+	return hexes
+}
+
 // Helper functions to convert between proto enums and strings
 func protoStatusFromString(status string) transaction.TransactionStatus {
 	switch status {
@@ -115,11 +330,20 @@ func protoTypeFromString(txType string) transaction.TransactionType {
 		return transaction.TransactionType_BUY
 	case "SELL":
 		return transaction.TransactionType_SELL
+	case "REFUND":
+		return transaction.TransactionType_REFUND
+	case "TRADE":
+		return transaction.TransactionType_TRADE
 	default:
 		return transaction.TransactionType_BUY
 	}
 }
 
+// StatusToProto converts a status to its proto enum
+func StatusToProto(status TransactionStatus) transaction.TransactionStatus {
+	return protoStatusFromString(string(status))
+}
+
 // Helper functions to convert from proto enums to strings
 func StatusFromProto(status transaction.TransactionStatus) TransactionStatus {
 	switch status {
@@ -142,6 +366,10 @@ func TypeFromProto(txType transaction.TransactionType) TransactionType {
 		return TypeBuy
 	case transaction.TransactionType_SELL:
 		return TypeSell
+	case transaction.TransactionType_REFUND:
+		return TypeRefund
+	case transaction.TransactionType_TRADE:
+		return TypeTrade
 	default:
 		return TypeBuy
 	}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCanTransitionTo(t *testing.T) {
+	statuses := []TransactionStatus{StatusPending, StatusCompleted, StatusFailed, StatusCancelled}
+	allowed := map[[2]TransactionStatus]bool{
+		{StatusPending, StatusCompleted}: true,
+		{StatusPending, StatusFailed}:    true,
+		{StatusPending, StatusCancelled}: true,
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			if got, want := from.CanTransitionTo(to), allowed[[2]TransactionStatus{from, to}]; got != want {
+				t.Errorf("%s -> %s: CanTransitionTo = %v, want %v", from, to, got, want)
+			}
+		}
+	}
+}
+
+func TestIsTerminal(t *testing.T) {
+	for _, status := range TerminalStatuses {
+		if !status.IsTerminal() {
+			t.Errorf("%s is not terminal", status)
+		}
+	}
+	if StatusPending.IsTerminal() {
+		t.Error("PENDING is terminal")
+	}
+}
+
+func TestCompletedByFlow(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Transaction
+		want bool
+	}{
+		{"plain transaction", Transaction{Type: TypeBuy}, false},
+		{"purchase", Transaction{Type: TypeBuy, Origin: OriginPurchase}, true},
+		{"cart item", Transaction{Type: TypeBuy, OrderID: primitive.NewObjectID()}, true},
+		{"trade", Transaction{Type: TypeTrade}, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.t.CompletedByFlow(); got != tt.want {
+			t.Errorf("%s: CompletedByFlow = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package models
+
+import "cs2-marketplace-microservices/shared/money"
+
+// Money and Conversion are the amount types shared by all services
+type (
+	Money      = money.Money
+	Conversion = money.Conversion
+)
+
+var (
+	MinorUnitDigits      = money.MinorUnitDigits
+	ValidCurrency        = money.ValidCurrency
+	MoneyFromProto       = money.FromProto
+	MajorUnits           = money.MajorUnits
+	ConversionsFromProto = money.ConversionsFromProto
+	ConversionsToProto   = money.ConversionsToProto
+	Convert              = money.Convert
+)
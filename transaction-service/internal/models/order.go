@@ -0,0 +1,82 @@
+package models
+
+import (
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type OrderStatus string
+
+const (
+	OrderPending   OrderStatus = "PENDING"
+	OrderCompleted OrderStatus = "COMPLETED" // every item was bought
+	OrderFailed    OrderStatus = "FAILED"    // nothing was bought
+)
+
+// Order is the parent of the transactions bought together in one cart
+// purchase. The buyer's funds for the whole cart are held once, on the order.
+type Order struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	BuyerID       primitive.ObjectID `bson:"buyer_id"`
+	Status        OrderStatus        `bson:"status"`
+	Total         Money              `bson:"total"`
+	Items         []OrderItem        `bson:"items"`
+	HoldID        string             `bson:"hold_id,omitempty"`
+	FailureReason string             `bson:"failure_reason,omitempty"`
+	CreatedAt     time.Time          `bson:"created_at"`
+	UpdatedAt     time.Time          `bson:"updated_at"`
+}
+
+// OrderItem is one skin of an order. Error is set on items that failed, or
+// that were not bought because another item failed.
+type OrderItem struct {
+	SkinID        primitive.ObjectID `bson:"skin_id"`
+	SkinName      string             `bson:"skin_name,omitempty"`
+	Rarity        string             `bson:"rarity,omitempty"`
+	SellerID      primitive.ObjectID `bson:"seller_id,omitempty"`
+	Price         Money              `bson:"price"`
+	TransactionID primitive.ObjectID `bson:"transaction_id,omitempty"`
+	Risk          *RiskAssessment    `bson:"risk,omitempty"`
+	Error         string             `bson:"error,omitempty"`
+}
+
+// Converts MongoDB model to Protobuf message
+func (o *Order) ToProto() *transaction.Order {
+	p := &transaction.Order{
+		Id:            o.ID.Hex(),
+		BuyerId:       o.BuyerID.Hex(),
+		Status:        OrderStatusToProto(o.Status),
+		Total:         o.Total.ToProto(),
+		FailureReason: o.FailureReason,
+		CreatedAt:     o.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     o.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, item := range o.Items {
+		pi := &transaction.OrderItem{
+			SkinId: item.SkinID.Hex(),
+			Price:  item.Price.ToProto(),
+			Error:  item.Error,
+		}
+		if !item.SellerID.IsZero() {
+			pi.SellerId = item.SellerID.Hex()
+		}
+		if !item.TransactionID.IsZero() {
+			pi.TransactionId = item.TransactionID.Hex()
+		}
+		p.Items = append(p.Items, pi)
+	}
+	return p
+}
+
+func OrderStatusToProto(status OrderStatus) transaction.OrderStatus {
+	switch status {
+	case OrderCompleted:
+		return transaction.OrderStatus_ORDER_COMPLETED
+	case OrderFailed:
+		return transaction.OrderStatus_ORDER_FAILED
+	default:
+		return transaction.OrderStatus_ORDER_PENDING
+	}
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Page selects a page of a list sorted by newest first. After takes precedence
+// over Offset; a zero Limit returns all remaining transactions.
+type Page struct {
+	Limit           int32
+	Offset          int32
+	After           *PageCursor
+	IncludeArchived bool
+}
+
+// PageCursor is the position of the last transaction of a page in the
+// (created_at, _id) sort order
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        primitive.ObjectID
+}
+
+// CursorOf returns the cursor positioned at t
+func CursorOf(t *Transaction) *PageCursor {
+	return &PageCursor{CreatedAt: t.CreatedAt, ID: t.ID}
+}
+
+// Token encodes the cursor as an opaque page token
+func (c *PageCursor) Token() string {
+	raw := fmt.Sprintf("%d:%s", c.CreatedAt.UnixMilli(), c.ID.Hex())
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParsePageToken decodes a page token; an empty token yields a nil cursor
+func ParsePageToken(token string) (*PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	millis, hexID, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, ErrInvalidPageToken
+	}
+
+	ms, err := strconv.ParseInt(millis, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	id, err := primitive.ObjectIDFromHex(hexID)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &PageCursor{CreatedAt: time.UnixMilli(ms).UTC(), ID: id}, nil
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	cursor := &PageCursor{
+		CreatedAt: time.Date(2024, 3, 1, 12, 30, 45, 123_000_000, time.UTC),
+		ID:        primitive.NewObjectID(),
+	}
+
+	got, err := ParsePageToken(cursor.Token())
+	if err != nil {
+		t.Fatalf("ParsePageToken: %v", err)
+	}
+	if !got.CreatedAt.Equal(cursor.CreatedAt) || got.ID != cursor.ID {
+		t.Errorf("ParsePageToken(Token()) = %+v, want %+v", got, cursor)
+	}
+}
+
+func TestPageTokenKeepsMilliseconds(t *testing.T) {
+	// Mongo stores times in milliseconds, so finer precision is dropped
+	cursor := &PageCursor{CreatedAt: time.Date(2024, 3, 1, 0, 0, 0, 123_456_789, time.UTC), ID: primitive.NewObjectID()}
+
+	got, err := ParsePageToken(cursor.Token())
+	if err != nil {
+		t.Fatalf("ParsePageToken: %v", err)
+	}
+	if want := cursor.CreatedAt.Truncate(time.Millisecond); !got.CreatedAt.Equal(want) {
+		t.Errorf("CreatedAt = %v, want %v", got.CreatedAt, want)
+	}
+}
+
+func TestParsePageTokenEmpty(t *testing.T) {
+	cursor, err := ParsePageToken("")
+	if cursor != nil || err != nil {
+		t.Errorf("ParsePageToken(\"\") = %v, %v, want nil, nil", cursor, err)
+	}
+}
+
+func TestParsePageTokenInvalid(t *testing.T) {
+	encode := func(raw string) string { return base64.RawURLEncoding.EncodeToString([]byte(raw)) }
+
+	tokens := map[string]string{
+		"not base64":       "!!!",
+		"no separator":     encode("1700000000000"),
+		"bad time":         encode("yesterday:" + primitive.NewObjectID().Hex()),
+		"bad id":           encode("1700000000000:xyz"),
+		"padded encoding":  base64.URLEncoding.EncodeToString([]byte("1700000000000:" + primitive.NewObjectID().Hex())),
+		"standard charset": "+/+/",
+	}
+
+	for name, token := range tokens {
+		if _, err := ParsePageToken(token); !errors.Is(err, ErrInvalidPageToken) {
+			t.Errorf("%s: ParsePageToken(%q) error = %v, want ErrInvalidPageToken", name, token, err)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Receipt of a completed purchase. Receipts are numbered sequentially when
+// they are issued and never change afterwards, except for EmailedAt.
+type Receipt struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	Number         int64              `bson:"number"`
+	TransactionID  primitive.ObjectID `bson:"transaction_id"`
+	BuyerID        primitive.ObjectID `bson:"buyer_id"`
+	BuyerUsername  string             `bson:"buyer_username"`
+	SellerID       primitive.ObjectID `bson:"seller_id"`
+	SellerUsername string             `bson:"seller_username"`
+	Items          []ReceiptItem      `bson:"items"`
+	Subtotal       Money              `bson:"subtotal"`
+	FeeAmount      Money              `bson:"fee_amount"`
+	SellerProceeds Money              `bson:"seller_proceeds"`
+	Total          Money              `bson:"total"`
+	IssuedAt       time.Time          `bson:"issued_at"`
+	EmailedAt      time.Time          `bson:"emailed_at,omitempty"`
+}
+
+type ReceiptItem struct {
+	SkinID    primitive.ObjectID `bson:"skin_id"`
+	Name      string             `bson:"name"`
+	Condition string             `bson:"condition,omitempty"`
+	Rarity    string             `bson:"rarity,omitempty"`
+	Price     Money              `bson:"price"`
+}
+
+// FormattedNumber returns the receipt number as shown to users
+func (r *Receipt) FormattedNumber() string {
+	return fmt.Sprintf("R-%08d", r.Number)
+}
+
+// Converts MongoDB model to Protobuf message, with the rendered receipt
+func (r *Receipt) ToProto(html, text string) *transaction.Receipt {
+	p := &transaction.Receipt{
+		Number:         r.FormattedNumber(),
+		TransactionId:  r.TransactionID.Hex(),
+		BuyerId:        r.BuyerID.Hex(),
+		BuyerUsername:  r.BuyerUsername,
+		SellerId:       r.SellerID.Hex(),
+		SellerUsername: r.SellerUsername,
+		Subtotal:       r.Subtotal.ToProto(),
+		FeeAmount:      r.FeeAmount.ToProto(),
+		SellerProceeds: r.SellerProceeds.ToProto(),
+		Total:          r.Total.ToProto(),
+		IssuedAt:       r.IssuedAt.UTC().Format(time.RFC3339),
+		Html:           html,
+		Text:           text,
+	}
+	for _, item := range r.Items {
+		p.Items = append(p.Items, &transaction.ReceiptItem{
+			SkinId:    item.SkinID.Hex(),
+			Name:      item.Name,
+			Condition: item.Condition,
+			Rarity:    item.Rarity,
+			Price:     item.Price.ToProto(),
+		})
+	}
+	return p
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type DiscrepancyType string
+
+const (
+	// The buyer's funds were never captured, or the seller was not credited
+	DiscrepancyMissingTransfer DiscrepancyType = "missing_transfer"
+	// The buyer's funds were captured more than once
+	DiscrepancyDoubleCharge DiscrepancyType = "double_charge"
+	// The skin is not owned by the user its latest transaction gave it to
+	DiscrepancyOrphanedSkinOwner DiscrepancyType = "orphaned_skin_owner"
+)
+
+// Discrepancy is an inconsistency between a completed transaction and the
+// state of the user or inventory service. IDs are hex strings so reports
+// can be read without knowing the storage format.
+type Discrepancy struct {
+	Type          DiscrepancyType `bson:"type" json:"type"`
+	TransactionID string          `bson:"transaction_id" json:"transaction_id"`
+	SkinID        string          `bson:"skin_id,omitempty" json:"skin_id,omitempty"`
+	UserID        string          `bson:"user_id,omitempty" json:"user_id,omitempty"`
+	Reference     string          `bson:"reference,omitempty" json:"reference,omitempty"` // of the balance holds checked
+	Expected      string          `bson:"expected" json:"expected"`
+	Actual        string          `bson:"actual" json:"actual"`
+	Detail        string          `bson:"detail,omitempty" json:"detail,omitempty"`
+}
+
+type RepairTaskStatus string
+
+const (
+	RepairTaskOpen RepairTaskStatus = "OPEN"
+)
+
+// RepairTask asks an operator to fix a discrepancy found by reconciliation.
+// A discrepancy found again while its task is open updates that task.
+type RepairTask struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Discrepancy `bson:",inline"`
+	Status      RepairTaskStatus `bson:"status"`
+	CreatedAt   time.Time        `bson:"created_at"`
+	UpdatedAt   time.Time        `bson:"updated_at"`
+}
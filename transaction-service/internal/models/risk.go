@@ -0,0 +1,76 @@
+package models
+
+import (
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"strings"
+)
+
+// RiskDecision is the outcome of the risk checks run before a transaction
+type RiskDecision string
+
+const (
+	RiskAllow  RiskDecision = "ALLOW"
+	RiskReview RiskDecision = "REVIEW" // allowed, but flagged for a manual review
+	RiskBlock  RiskDecision = "BLOCK"
+)
+
+// Severity orders decisions from allow to block
+func (d RiskDecision) Severity() int {
+	switch d {
+	case RiskReview:
+		return 1
+	case RiskBlock:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// RiskFinding is a risk rule that triggered and what it decided
+type RiskFinding struct {
+	Rule     string       `bson:"rule"`
+	Decision RiskDecision `bson:"decision"`
+	Reason   string       `bson:"reason"`
+}
+
+// RiskAssessment is the most severe decision of all risk rules, with every
+// rule that triggered
+type RiskAssessment struct {
+	Decision RiskDecision  `bson:"decision"`
+	Findings []RiskFinding `bson:"findings,omitempty"`
+}
+
+// Reasons joins the reasons of all findings
+func (a *RiskAssessment) Reasons() string {
+	reasons := make([]string, 0, len(a.Findings))
+	for _, f := range a.Findings {
+		reasons = append(reasons, f.Reason)
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// Converts MongoDB model to Protobuf message
+func (a *RiskAssessment) ToProto() *transaction.RiskAssessment {
+	p := &transaction.RiskAssessment{
+		Decision: RiskDecisionToProto(a.Decision),
+	}
+	for _, f := range a.Findings {
+		p.Findings = append(p.Findings, &transaction.RiskFinding{
+			Rule:     f.Rule,
+			Decision: RiskDecisionToProto(f.Decision),
+			Reason:   f.Reason,
+		})
+	}
+	return p
+}
+
+func RiskDecisionToProto(decision RiskDecision) transaction.RiskDecision {
+	switch decision {
+	case RiskReview:
+		return transaction.RiskDecision_RISK_REVIEW
+	case RiskBlock:
+		return transaction.RiskDecision_RISK_BLOCK
+	default:
+		return transaction.RiskDecision_RISK_ALLOW
+	}
+}
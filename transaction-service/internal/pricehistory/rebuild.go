@@ -0,0 +1,153 @@
+// Package pricehistory rebuilds the pre-aggregated price candles from the
+// completed sales. Candles are otherwise only updated as sales complete, so a
+// rebuild fills in sales from before the candles existed and sales whose
+// recording failed, and takes out sales that were refunded later.
+package pricehistory
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/internal/repository"
+	"cs2-marketplace-microservices/transaction-service/proto/inventory"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Result counts what a rebuild did
+type Result struct {
+	Sales        int // sales folded into the candles
+	Refunded     int // sales left out because they were refunded
+	UnknownSkins int // sales left out because their skin no longer exists
+	Candles      int
+}
+
+// candleKey identifies one candle
+type candleKey struct {
+	skinName, condition, currency string
+	interval                      models.CandleInterval
+	start                         time.Time
+}
+
+// skinInfo is the part of a skin the candles are keyed by
+type skinInfo struct {
+	name, condition string
+	found           bool
+}
+
+type Rebuilder struct {
+	transactionRepo repository.TransactionRepository
+	priceRepo       repository.PriceHistoryRepository
+	inventoryClient inventory.InventoryServiceClient
+}
+
+func New(transactionRepo repository.TransactionRepository, priceRepo repository.PriceHistoryRepository, inventoryClient inventory.InventoryServiceClient) *Rebuilder {
+	return &Rebuilder{
+		transactionRepo: transactionRepo,
+		priceRepo:       priceRepo,
+		inventoryClient: inventoryClient,
+	}
+}
+
+// Run recomputes every candle from the live and archived completed purchases
+// and replaces the stored candles with them. The completed transactions are
+// read twice: first for the refunds, then for the sales.
+func (r *Rebuilder) Run(ctx context.Context) (*Result, error) {
+	refunded := make(map[primitive.ObjectID]bool)
+	err := r.transactionRepo.StreamCompletedTransactions(ctx, func(t *models.Transaction) error {
+		if !t.RefundOfID.IsZero() {
+			refunded[t.RefundOfID] = true
+		}
+		if !t.RefundTransactionID.IsZero() {
+			refunded[t.ID] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read refunds: %v", err)
+	}
+
+	result := &Result{}
+	skins := make(map[primitive.ObjectID]skinInfo)
+	candles := make(map[candleKey]*models.Candle)
+
+	err = r.transactionRepo.StreamCompletedTransactions(ctx, func(t *models.Transaction) error {
+		if t.Type != models.TypeBuy && t.Type != models.TypeSell {
+			return nil
+		}
+		if refunded[t.ID] {
+			result.Refunded++
+			return nil
+		}
+
+		skin, err := r.skin(ctx, skins, t.SkinID)
+		if err != nil {
+			return err
+		}
+		if !skin.found {
+			result.UnknownSkins++
+			return nil
+		}
+
+		sale := models.Sale{
+			SkinName:  skin.name,
+			Condition: skin.condition,
+			Price:     t.Amount,
+			At:        t.CompletedAt(),
+		}
+		for _, interval := range models.CandleIntervals {
+			key := candleKey{sale.SkinName, sale.Condition, sale.Price.Currency, interval, interval.Start(sale.At)}
+			candle, ok := candles[key]
+			if !ok {
+				candle = &models.Candle{
+					SkinName:  key.skinName,
+					Condition: key.condition,
+					Currency:  key.currency,
+					Interval:  key.interval,
+					Start:     key.start,
+				}
+				candles[key] = candle
+			}
+			candle.Add(sale)
+		}
+		result.Sales++
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sales: %v", err)
+	}
+
+	rebuilt := make([]models.Candle, 0, len(candles))
+	for _, candle := range candles {
+		rebuilt = append(rebuilt, *candle)
+	}
+	if err := r.priceRepo.ReplaceCandles(ctx, rebuilt); err != nil {
+		return nil, fmt.Errorf("failed to replace candles: %v", err)
+	}
+	result.Candles = len(rebuilt)
+
+	return result, nil
+}
+
+// skin looks up the name and condition of a skin once per rebuild
+func (r *Rebuilder) skin(ctx context.Context, skins map[primitive.ObjectID]skinInfo, skinID primitive.ObjectID) (skinInfo, error) {
+	if info, ok := skins[skinID]; ok {
+		return info, nil
+	}
+
+	var info skinInfo
+	skinResp, err := r.inventoryClient.GetSkin(ctx, &inventory.GetSkinRequest{Id: skinID.Hex()})
+	switch {
+	case status.Code(err) == codes.NotFound:
+	case err != nil:
+		return info, fmt.Errorf("failed to get skin %s: %v", skinID.Hex(), err)
+	default:
+		info = skinInfo{name: skinResp.GetSkin().GetName(), condition: skinResp.GetSkin().GetCondition(), found: true}
+	}
+
+	skins[skinID] = info
+	return info, nil
+}
@@ -0,0 +1,66 @@
+package receipt
+
+import (
+	"bytes"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	htmltemplate "html/template"
+	"text/template"
+	"time"
+)
+
+var funcs = map[string]interface{}{
+	"date": func(t time.Time) string { return t.UTC().Format("2006-01-02 15:04 MST") },
+}
+
+var textTemplate = template.Must(template.New("receipt.txt").Funcs(funcs).Parse(`CS2 Skins Marketplace - Receipt {{.FormattedNumber}}
+Issued: {{date .IssuedAt}}
+Transaction: {{.TransactionID.Hex}}
+
+Buyer:  {{.BuyerUsername}}
+Seller: {{.SellerUsername}}
+
+Items:
+{{range .Items}}  {{.Name}}{{if .Condition}} ({{.Condition}}){{end}}{{if .Rarity}} - {{.Rarity}}{{end}}    {{.Price}}
+{{end}}
+Subtotal:           {{.Subtotal}}
+Total paid:         {{.Total}}
+
+Marketplace fee:    {{.FeeAmount}} (withheld from the seller)
+Seller proceeds:    {{.SellerProceeds}}
+`))
+
+var htmlTemplate = htmltemplate.Must(htmltemplate.New("receipt.html").Funcs(funcs).Parse(`<!DOCTYPE html>
+<html>
+<body style="font-family: Arial, sans-serif; color: #222;">
+  <h2>Receipt {{.FormattedNumber}}</h2>
+  <p>Issued {{date .IssuedAt}}<br>Transaction {{.TransactionID.Hex}}</p>
+  <p><strong>Buyer:</strong> {{.BuyerUsername}}<br><strong>Seller:</strong> {{.SellerUsername}}</p>
+  <table cellpadding="6" style="border-collapse: collapse;">
+    <tr style="border-bottom: 1px solid #ccc;"><th align="left">Item</th><th align="left">Condition</th><th align="left">Rarity</th><th align="right">Price</th></tr>
+    {{range .Items}}<tr><td>{{.Name}}</td><td>{{.Condition}}</td><td>{{.Rarity}}</td><td align="right">{{.Price}}</td></tr>
+    {{end}}<tr style="border-top: 1px solid #ccc;"><td colspan="3">Subtotal</td><td align="right">{{.Subtotal}}</td></tr>
+    <tr><td colspan="3"><strong>Total paid</strong></td><td align="right"><strong>{{.Total}}</strong></td></tr>
+    <tr><td colspan="3">Marketplace fee (withheld from the seller)</td><td align="right">{{.FeeAmount}}</td></tr>
+    <tr><td colspan="3">Seller proceeds</td><td align="right">{{.SellerProceeds}}</td></tr>
+  </table>
+</body>
+</html>
+`))
+
+// Text renders a receipt as plain text
+func Text(r *models.Receipt) (string, error) {
+	var buf bytes.Buffer
+	if err := textTemplate.Execute(&buf, r); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// HTML renders a receipt as an HTML document
+func HTML(r *models.Receipt) (string, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, r); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
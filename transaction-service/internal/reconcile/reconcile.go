@@ -0,0 +1,283 @@
+// Package reconcile checks completed transactions against the balances held
+// by user-service and the skin owners recorded by inventory-service. The three
+// services keep separate databases, so a failed compensation or a partial
+// outage can leave them disagreeing about what a transaction did.
+package reconcile
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/internal/repository"
+	"cs2-marketplace-microservices/transaction-service/proto/inventory"
+	"cs2-marketplace-microservices/transaction-service/proto/user"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// holdCaptured is the status user-service gives a captured hold
+const holdCaptured = "CAPTURED"
+
+// Report is the machine-readable result of a reconciliation run
+type Report struct {
+	GeneratedAt         time.Time            `json:"generated_at"`
+	TransactionsChecked int                  `json:"transactions_checked"`
+	HoldsChecked        int                  `json:"holds_checked"` // hold references, one per transaction or order
+	SkinsChecked        int                  `json:"skins_checked"`
+	Discrepancies       []models.Discrepancy `json:"discrepancies"`
+}
+
+// Counts returns the number of discrepancies of each type
+func (r *Report) Counts() map[models.DiscrepancyType]int {
+	counts := make(map[models.DiscrepancyType]int)
+	for _, d := range r.Discrepancies {
+		counts[d.Type]++
+	}
+	return counts
+}
+
+// ownerClaim is the owner a skin should have after the latest completed
+// transaction that moved it
+type ownerClaim struct {
+	transactionID primitive.ObjectID
+	at            time.Time
+	owners        []primitive.ObjectID // any of them is consistent
+}
+
+type Reconciler struct {
+	transactionRepo repository.TransactionRepository
+	inventoryClient inventory.InventoryServiceClient
+	userClient      user.UserServiceClient
+}
+
+func New(transactionRepo repository.TransactionRepository, inventoryClient inventory.InventoryServiceClient, userClient user.UserServiceClient) *Reconciler {
+	return &Reconciler{
+		transactionRepo: transactionRepo,
+		inventoryClient: inventoryClient,
+		userClient:      userClient,
+	}
+}
+
+// Run walks every completed transaction. The balance holds of each are
+// checked as it is read; skin owners are checked at the end against the
+// latest transaction of each skin.
+func (r *Reconciler) Run(ctx context.Context) (*Report, error) {
+	report := &Report{
+		GeneratedAt:   time.Now().UTC(),
+		Discrepancies: []models.Discrepancy{},
+	}
+	claims := make(map[primitive.ObjectID]ownerClaim)
+	checkedOrders := make(map[primitive.ObjectID]bool)
+
+	err := r.transactionRepo.StreamCompletedTransactions(ctx, func(t *models.Transaction) error {
+		report.TransactionsChecked++
+		addOwnerClaims(claims, t)
+
+		// Cart items are paid from the single hold of their order
+		if !t.OrderID.IsZero() {
+			if checkedOrders[t.OrderID] {
+				return nil
+			}
+			checkedOrders[t.OrderID] = true
+		}
+
+		found, err := r.checkHolds(ctx, t)
+		if err != nil {
+			return err
+		}
+		if found != nil {
+			report.HoldsChecked++
+			report.Discrepancies = append(report.Discrepancies, found...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconcile transactions: %w", err)
+	}
+
+	// Sorted so reports of an unchanged database are identical
+	skinIDs := make([]primitive.ObjectID, 0, len(claims))
+	for skinID := range claims {
+		skinIDs = append(skinIDs, skinID)
+	}
+	sort.Slice(skinIDs, func(i, j int) bool { return skinIDs[i].Hex() < skinIDs[j].Hex() })
+
+	for _, skinID := range skinIDs {
+		d, err := r.checkOwner(ctx, skinID, claims[skinID])
+		if err != nil {
+			return nil, err
+		}
+		report.SkinsChecked++
+		if d != nil {
+			report.Discrepancies = append(report.Discrepancies, *d)
+		}
+	}
+
+	return report, nil
+}
+
+// addOwnerClaims records who owns the skins moved by t, unless a later
+// transaction already moved them
+func addOwnerClaims(claims map[primitive.ObjectID]ownerClaim, t *models.Transaction) {
+	at := completedAt(t)
+	claim := func(skinID primitive.ObjectID, owners ...primitive.ObjectID) {
+		if skinID.IsZero() {
+			return
+		}
+		if prev, ok := claims[skinID]; ok && prev.at.After(at) {
+			return
+		}
+		claims[skinID] = ownerClaim{transactionID: t.ID, at: at, owners: owners}
+	}
+
+	switch t.Type {
+	case models.TypeTrade:
+		for _, skinID := range t.BuyerSkinIDs {
+			claim(skinID, t.SellerID)
+		}
+		for _, skinID := range t.SellerSkinIDs {
+			claim(skinID, t.BuyerID)
+		}
+	case models.TypeRefund:
+		// The skin goes back to the seller only if the dispute returned the item
+		claim(t.SkinID, t.BuyerID, t.SellerID)
+	default:
+		claim(t.SkinID, t.BuyerID)
+	}
+}
+
+// completedAt returns when t was completed, falling back to its last update
+// for transactions recorded before the history was kept
+func completedAt(t *models.Transaction) time.Time {
+	for i := len(t.History) - 1; i >= 0; i-- {
+		if t.History[i].To == models.StatusCompleted {
+			return t.History[i].At
+		}
+	}
+	return t.UpdatedAt
+}
+
+// checkHolds compares the balance holds placed for t, or for its order, with
+// the single capture a completed transaction implies. It returns nil if t
+// moved no funds through a hold.
+func (r *Reconciler) checkHolds(ctx context.Context, t *models.Transaction) ([]models.Discrepancy, error) {
+	var reference string
+	switch {
+	case !t.OrderID.IsZero():
+		reference = t.OrderID.Hex()
+	case t.HoldID != "":
+		reference = t.ID.Hex()
+	default:
+		return nil, nil
+	}
+
+	resp, err := r.userClient.ListHolds(ctx, &user.ListHoldsRequest{Reference: reference})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list holds for %s: %v", reference, err)
+	}
+
+	var captured []*user.BalanceHold
+	var states []string
+	for _, hold := range resp.GetHolds() {
+		states = append(states, fmt.Sprintf("%s %s", hold.GetId(), hold.GetStatus()))
+		if hold.GetStatus() == holdCaptured {
+			captured = append(captured, hold)
+		}
+	}
+
+	found := []models.Discrepancy{}
+	newDiscrepancy := func(typ models.DiscrepancyType, userID primitive.ObjectID, expected, actual, detail string) {
+		found = append(found, models.Discrepancy{
+			Type:          typ,
+			TransactionID: t.ID.Hex(),
+			UserID:        userID.Hex(),
+			Reference:     reference,
+			Expected:      expected,
+			Actual:        actual,
+			Detail:        detail,
+		})
+	}
+
+	switch {
+	case len(captured) == 0:
+		actual := "no holds"
+		if len(states) > 0 {
+			actual = strings.Join(states, ", ")
+		}
+		newDiscrepancy(models.DiscrepancyMissingTransfer, t.BuyerID, "1 captured hold", actual,
+			"the buyer's funds were never taken")
+	case len(captured) > 1:
+		newDiscrepancy(models.DiscrepancyDoubleCharge, t.BuyerID, "1 captured hold",
+			fmt.Sprintf("%d captured holds", len(captured)), strings.Join(states, ", "))
+	case t.OrderID.IsZero() && !t.SellerID.IsZero() && captured[0].GetCapturedTo() != t.SellerID.Hex():
+		// Cart sellers are paid outside the hold, so only direct captures name the seller
+		newDiscrepancy(models.DiscrepancyMissingTransfer, t.SellerID, "captured to "+t.SellerID.Hex(),
+			"captured to "+emptyAsNone(captured[0].GetCapturedTo()), "the seller was not credited")
+	}
+
+	return found, nil
+}
+
+// checkOwner compares the current owner of a skin with the owner its latest
+// completed transaction gave it to
+func (r *Reconciler) checkOwner(ctx context.Context, skinID primitive.ObjectID, claim ownerClaim) (*models.Discrepancy, error) {
+	expected := make([]string, 0, len(claim.owners))
+	for _, owner := range claim.owners {
+		expected = append(expected, owner.Hex())
+	}
+
+	d := &models.Discrepancy{
+		Type:          models.DiscrepancyOrphanedSkinOwner,
+		TransactionID: claim.transactionID.Hex(),
+		SkinID:        skinID.Hex(),
+		UserID:        expected[0],
+		Expected:      strings.Join(expected, " or "),
+	}
+
+	resp, err := r.inventoryClient.GetSkin(ctx, &inventory.GetSkinRequest{Id: skinID.Hex()})
+	if status.Code(err) == codes.NotFound {
+		d.Actual = "none"
+		d.Detail = "the skin no longer exists in inventory"
+		return d, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skin %s: %v", skinID.Hex(), err)
+	}
+
+	owner := resp.GetSkin().GetOwnerId()
+	for _, e := range expected {
+		if owner == e {
+			return nil, nil
+		}
+	}
+	d.Actual = emptyAsNone(owner)
+	return d, nil
+}
+
+// OpenRepairTasks opens a repair task for each discrepancy of report and
+// returns how many were not already open
+func OpenRepairTasks(ctx context.Context, repairTaskRepo repository.RepairTaskRepository, report *Report) (int, error) {
+	opened := 0
+	for _, d := range report.Discrepancies {
+		created, err := repairTaskRepo.OpenRepairTask(ctx, d)
+		if err != nil {
+			return opened, fmt.Errorf("failed to open repair task for transaction %s: %w", d.TransactionID, err)
+		}
+		if created {
+			opened++
+		}
+	}
+	return opened, nil
+}
+
+func emptyAsNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
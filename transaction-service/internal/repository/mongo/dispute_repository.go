@@ -0,0 +1,150 @@
+package mongo
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type DisputeRepository struct {
+	collection *mongo.Collection
+}
+
+func NewDisputeRepository(db *mongo.Database) *DisputeRepository {
+	return &DisputeRepository{
+		collection: db.Collection("disputes"),
+	}
+}
+
+// EnsureIndexes creates the indexes the repository relies on
+func (r *DisputeRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			// A transaction can only have one open dispute at a time
+			Keys: bson.D{{Key: "transaction_id", Value: 1}},
+			Options: options.Index().
+				SetName("transaction_id_open_unique").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": models.DisputeOpen}),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("status_created_at"),
+		},
+	})
+	return err
+}
+
+// CreateDispute inserts a new open dispute
+func (r *DisputeRepository) CreateDispute(ctx context.Context, dispute *models.Dispute) (*models.Dispute, error) {
+	now := time.Now()
+	dispute.Status = models.DisputeOpen
+	dispute.CreatedAt = now
+	dispute.UpdatedAt = now
+
+	result, err := r.collection.InsertOne(ctx, dispute)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, models.ErrDisputeExists
+		}
+		return nil, err
+	}
+
+	dispute.ID = result.InsertedID.(primitive.ObjectID)
+	return dispute, nil
+}
+
+// GetDisputeByID retrieves a dispute by its ID
+func (r *DisputeRepository) GetDisputeByID(ctx context.Context, id primitive.ObjectID) (*models.Dispute, error) {
+	var dispute models.Dispute
+
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&dispute)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrDisputeNotFound
+		}
+		return nil, err
+	}
+
+	return &dispute, nil
+}
+
+// ListDisputes retrieves disputes oldest first, optionally filtered by status
+func (r *DisputeRepository) ListDisputes(ctx context.Context, status *models.DisputeStatus, limit, offset int32) ([]models.Dispute, int64, error) {
+	filter := bson.M{}
+	if status != nil {
+		filter["status"] = *status
+	}
+
+	totalCount, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}) // Oldest first, like a queue
+
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	if offset > 0 {
+		opts.SetSkip(int64(offset))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var disputes []models.Dispute
+	if err := cursor.All(ctx, &disputes); err != nil {
+		return nil, 0, err
+	}
+
+	return disputes, totalCount, nil
+}
+
+// UpdateDisputeStatus sets the fields in update and removes the fields in unset
+// on a dispute that is still in status from. It fails with ErrDisputeNotOpen
+// if the dispute has moved on.
+func (r *DisputeRepository) UpdateDisputeStatus(ctx context.Context, id primitive.ObjectID, from models.DisputeStatus, update bson.M, unset ...string) (*models.Dispute, error) {
+	update["updated_at"] = time.Now()
+
+	change := bson.M{"$set": update}
+	if len(unset) > 0 {
+		fields := bson.M{}
+		for _, field := range unset {
+			fields[field] = ""
+		}
+		change["$unset"] = fields
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var dispute models.Dispute
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": id, "status": from}, change, opts).Decode(&dispute)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, err
+		}
+
+		// Distinguish a missing dispute from one in another status
+		count, countErr := r.collection.CountDocuments(ctx, bson.M{"_id": id})
+		if countErr != nil {
+			return nil, countErr
+		}
+		if count == 0 {
+			return nil, models.ErrDisputeNotFound
+		}
+		return nil, models.ErrDisputeNotOpen
+	}
+
+	return &dispute, nil
+}
@@ -0,0 +1,21 @@
+package mongo
+
+import (
+	"context"
+	"cs2-marketplace-microservices/shared/money"
+)
+
+// moneyFields are the transaction fields that hold Money
+var moneyFields = []string{"amount", "fee_amount", "seller_proceeds"}
+
+// MigrateMoney converts the amounts of live and archived transactions stored
+// as plain numbers to Money in currency
+func (r *TransactionRepository) MigrateMoney(ctx context.Context, currency string) (int64, error) {
+	live, err := money.MigrateFields(ctx, r.collection, currency, moneyFields...)
+	if err != nil {
+		return live, err
+	}
+
+	archived, err := money.MigrateFields(ctx, r.archive, currency, moneyFields...)
+	return live + archived, err
+}
@@ -0,0 +1,54 @@
+package mongo
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type OrderRepository struct {
+	collection *mongo.Collection
+}
+
+func NewOrderRepository(db *mongo.Database) *OrderRepository {
+	return &OrderRepository{
+		collection: db.Collection("orders"),
+	}
+}
+
+// EnsureIndexes creates the indexes the repository relies on
+func (r *OrderRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "buyer_id", Value: 1}, {Key: "created_at", Value: -1}},
+		Options: options.Index().SetName("buyer_id_created_at"),
+	})
+	return err
+}
+
+// CreateOrder inserts a new order
+func (r *OrderRepository) CreateOrder(ctx context.Context, order *models.Order) (*models.Order, error) {
+	now := time.Now()
+	order.CreatedAt = now
+	order.UpdatedAt = now
+
+	result, err := r.collection.InsertOne(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	order.ID = result.InsertedID.(primitive.ObjectID)
+	return order, nil
+}
+
+// SaveOrder replaces a stored order with its current state
+func (r *OrderRepository) SaveOrder(ctx context.Context, order *models.Order) error {
+	order.UpdatedAt = time.Now()
+
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": order.ID}, order)
+	return err
+}
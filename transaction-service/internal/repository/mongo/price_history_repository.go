@@ -0,0 +1,187 @@
+package mongo
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// PriceHistoryRepository keeps pre-aggregated price candles per skin name and
+// condition, one document per interval and bucket
+type PriceHistoryRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPriceHistoryRepository(db *mongo.Database) *PriceHistoryRepository {
+	return &PriceHistoryRepository{
+		collection: db.Collection("skin_price_candles"),
+	}
+}
+
+// EnsureIndexes creates the indexes the repository relies on
+func (r *PriceHistoryRepository) EnsureIndexes(ctx context.Context) error {
+	return ensureCandleIndexes(ctx, r.collection)
+}
+
+func ensureCandleIndexes(ctx context.Context, collection *mongo.Collection) error {
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "skin_name", Value: 1},
+			{Key: "condition", Value: 1},
+			{Key: "currency", Value: 1},
+			{Key: "interval", Value: 1},
+			{Key: "start", Value: 1},
+		},
+		Options: options.Index().SetName("skin_interval_start_unique").SetUnique(true),
+	})
+	return err
+}
+
+// RecordSale adds a sale to the candles of every interval
+func (r *PriceHistoryRepository) RecordSale(ctx context.Context, sale models.Sale) error {
+	writes := make([]mongo.WriteModel, 0, len(models.CandleIntervals))
+	for _, interval := range models.CandleIntervals {
+		filter := bson.M{
+			"skin_name": sale.SkinName,
+			"condition": sale.Condition,
+			"currency":  sale.Price.Currency,
+			"interval":  interval,
+			"start":     interval.Start(sale.At),
+		}
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(filter).
+			SetUpdate(candleUpdate(sale)).
+			SetUpsert(true))
+	}
+
+	opts := options.BulkWrite().SetOrdered(false)
+	_, err := r.collection.BulkWrite(ctx, writes, opts)
+	if retry := duplicateKeyWrites(err, writes); len(retry) > 0 {
+		// Two sales created the same candles at once; the retry updates them.
+		// The other writes succeeded and must not be applied twice.
+		_, err = r.collection.BulkWrite(ctx, retry, opts)
+	}
+	return err
+}
+
+// duplicateKeyWrites returns the writes of an unordered bulk write that failed
+// only because of duplicate keys, or nil if any write failed otherwise
+func duplicateKeyWrites(err error, writes []mongo.WriteModel) []mongo.WriteModel {
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil {
+		return nil
+	}
+
+	retry := make([]mongo.WriteModel, 0, len(bulkErr.WriteErrors))
+	for _, writeErr := range bulkErr.WriteErrors {
+		if !mongo.IsDuplicateKeyError(writeErr.WriteError) {
+			return nil
+		}
+		retry = append(retry, writes[writeErr.Index])
+	}
+	return retry
+}
+
+// ReplaceCandles replaces all candles with the given ones. They are written to
+// a staging collection first, which is then renamed over the live one, so
+// readers never see a partial set. Sales recorded while the replacement
+// candles were computed are lost.
+func (r *PriceHistoryRepository) ReplaceCandles(ctx context.Context, candles []models.Candle) error {
+	db := r.collection.Database()
+	staging := db.Collection(r.collection.Name() + "_rebuild")
+
+	if err := staging.Drop(ctx); err != nil {
+		return err
+	}
+	if err := ensureCandleIndexes(ctx, staging); err != nil {
+		return err
+	}
+
+	const batchSize = 1000
+	for start := 0; start < len(candles); start += batchSize {
+		end := min(start+batchSize, len(candles))
+		docs := make([]interface{}, 0, end-start)
+		for i := start; i < end; i++ {
+			docs = append(docs, candles[i])
+		}
+		if _, err := staging.InsertMany(ctx, docs); err != nil {
+			return err
+		}
+	}
+
+	return db.Client().Database("admin").RunCommand(ctx, bson.D{
+		{Key: "renameCollection", Value: db.Name() + "." + staging.Name()},
+		{Key: "to", Value: db.Name() + "." + r.collection.Name()},
+		{Key: "dropTarget", Value: true},
+	}).Err()
+}
+
+// candleUpdate folds a sale into a candle. All expressions of a $set stage see
+// the candle as it was before the update, and a new candle has no fields yet.
+func candleUpdate(sale models.Sale) []bson.M {
+	price := sale.Price.Units
+	missing := func(field string) bson.M {
+		return bson.M{"$eq": bson.A{bson.M{"$type": field}, "missing"}}
+	}
+
+	return []bson.M{{"$set": bson.M{
+		"open": bson.M{"$cond": bson.A{
+			bson.M{"$or": bson.A{missing("$open_at"), bson.M{"$lt": bson.A{sale.At, "$open_at"}}}},
+			price,
+			"$open",
+		}},
+		"close": bson.M{"$cond": bson.A{
+			bson.M{"$or": bson.A{missing("$close_at"), bson.M{"$gte": bson.A{sale.At, "$close_at"}}}},
+			price,
+			"$close",
+		}},
+		"open_at":  bson.M{"$min": bson.A{"$open_at", sale.At}},
+		"close_at": bson.M{"$max": bson.A{"$close_at", sale.At}},
+		"high":     bson.M{"$max": bson.A{"$high", price}},
+		"low":      bson.M{"$min": bson.A{"$low", price}},
+		"volume":   bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$volume", int64(0)}}, price}},
+		"sales":    bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$sales", int64(0)}}, int64(1)}},
+	}}}
+}
+
+// GetCandles returns the candles of a skin name and condition oldest first,
+// optionally limited to buckets starting within [from, to)
+func (r *PriceHistoryRepository) GetCandles(ctx context.Context, skinName, condition, currency string, interval models.CandleInterval, from, to *time.Time) ([]models.Candle, error) {
+	filter := bson.M{
+		"skin_name": skinName,
+		"condition": condition,
+		"currency":  currency,
+		"interval":  interval,
+	}
+
+	start := bson.M{}
+	if from != nil {
+		// The bucket containing from is included
+		start["$gte"] = interval.Start(*from)
+	}
+	if to != nil {
+		start["$lt"] = *to
+	}
+	if len(start) > 0 {
+		filter["start"] = start
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "start", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var candles []models.Candle
+	if err := cursor.All(ctx, &candles); err != nil {
+		return nil, err
+	}
+
+	return candles, nil
+}
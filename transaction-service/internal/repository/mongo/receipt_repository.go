@@ -0,0 +1,96 @@
+package mongo
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// receiptCounterID is the counter document receipt numbers are taken from
+const receiptCounterID = "receipt_number"
+
+type ReceiptRepository struct {
+	collection *mongo.Collection
+	counters   *mongo.Collection
+}
+
+func NewReceiptRepository(db *mongo.Database) *ReceiptRepository {
+	return &ReceiptRepository{
+		collection: db.Collection("receipts"),
+		counters:   db.Collection("counters"),
+	}
+}
+
+// EnsureIndexes creates the indexes the repository relies on
+func (r *ReceiptRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			// A transaction has at most one receipt
+			Keys:    bson.D{{Key: "transaction_id", Value: 1}},
+			Options: options.Index().SetName("transaction_id_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "number", Value: 1}},
+			Options: options.Index().SetName("number_unique").SetUnique(true),
+		},
+	})
+	return err
+}
+
+// CreateReceipt assigns the next receipt number and inserts the receipt.
+// ErrDuplicateReceipt is returned if the transaction already has a receipt.
+func (r *ReceiptRepository) CreateReceipt(ctx context.Context, receipt *models.Receipt) (*models.Receipt, error) {
+	var counter struct {
+		Seq int64 `bson:"seq"`
+	}
+	err := r.counters.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": receiptCounterID},
+		bson.M{"$inc": bson.M{"seq": 1}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&counter)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt.Number = counter.Seq
+	receipt.IssuedAt = time.Now()
+
+	result, err := r.collection.InsertOne(ctx, receipt)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, models.ErrDuplicateReceipt
+		}
+		return nil, err
+	}
+
+	receipt.ID = result.InsertedID.(primitive.ObjectID)
+	return receipt, nil
+}
+
+// GetReceiptByTransactionID retrieves the receipt of a transaction
+func (r *ReceiptRepository) GetReceiptByTransactionID(ctx context.Context, transactionID primitive.ObjectID) (*models.Receipt, error) {
+	var receipt models.Receipt
+
+	err := r.collection.FindOne(ctx, bson.M{"transaction_id": transactionID}).Decode(&receipt)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrReceiptNotFound
+		}
+		return nil, err
+	}
+
+	return &receipt, nil
+}
+
+// MarkReceiptEmailed records when a receipt was emailed to the buyer
+func (r *ReceiptRepository) MarkReceiptEmailed(ctx context.Context, id primitive.ObjectID, at time.Time) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"emailed_at": at}})
+	return err
+}
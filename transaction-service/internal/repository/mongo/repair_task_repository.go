@@ -0,0 +1,64 @@
+package mongo
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RepairTaskRepository struct {
+	collection *mongo.Collection
+}
+
+func NewRepairTaskRepository(db *mongo.Database) *RepairTaskRepository {
+	return &RepairTaskRepository{
+		collection: db.Collection("repair_tasks"),
+	}
+}
+
+// EnsureIndexes creates the indexes the repository relies on
+func (r *RepairTaskRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		// At most one open task per discrepancy
+		Keys: bson.D{{Key: "type", Value: 1}, {Key: "transaction_id", Value: 1}, {Key: "skin_id", Value: 1}},
+		Options: options.Index().
+			SetName("open_discrepancy").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"status": models.RepairTaskOpen}),
+	})
+	return err
+}
+
+// OpenRepairTask opens a task for d, or refreshes the open task already
+// recording it. It reports whether a new task was opened.
+func (r *RepairTaskRepository) OpenRepairTask(ctx context.Context, d models.Discrepancy) (bool, error) {
+	now := time.Now()
+
+	filter := bson.M{
+		"type":           d.Type,
+		"transaction_id": d.TransactionID,
+		"skin_id":        d.SkinID,
+		"status":         models.RepairTaskOpen,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"user_id":    d.UserID,
+			"reference":  d.Reference,
+			"expected":   d.Expected,
+			"actual":     d.Actual,
+			"detail":     d.Detail,
+			"updated_at": now,
+		},
+		"$setOnInsert": bson.M{"created_at": now},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return false, err
+	}
+	return result.UpsertedCount > 0, nil
+}
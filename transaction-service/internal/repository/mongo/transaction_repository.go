@@ -3,6 +3,11 @@ package mongo
 import (
 	"context"
 	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -13,12 +18,124 @@ import (
 
 type TransactionRepository struct {
 	collection *mongo.Collection
+	archive    *mongo.Collection // old terminal transactions moved out of collection
+
+	percentileMu sync.Mutex
+	percentile   *bool // whether the server has $percentile, once known
 }
 
 func NewTransactionRepository(db *mongo.Database) *TransactionRepository {
 	return &TransactionRepository{
 		collection: db.Collection("transactions"),
+		archive:    db.Collection("transactions_archive"),
+	}
+}
+
+// notDeleted matches the transactions that have not been soft deleted
+var notDeleted = bson.M{"deleted_at": bson.M{"$exists": false}}
+
+// withoutDeleted restricts filter to transactions that have not been soft deleted
+func withoutDeleted(filter bson.M) bson.M {
+	if len(filter) == 0 {
+		return notDeleted
+	}
+	return bson.M{"$and": []bson.M{filter, notDeleted}}
+}
+
+// unionArchive is a pipeline stage adding the archived documents matching filter
+func (r *TransactionRepository) unionArchive(filter bson.M) bson.M {
+	return bson.M{"$unionWith": bson.M{
+		"coll":     r.archive.Name(),
+		"pipeline": []bson.M{{"$match": filter}},
+	}}
+}
+
+// EnsureIndexes creates the indexes the repository relies on
+func (r *TransactionRepository) EnsureIndexes(ctx context.Context) error {
+	// Idempotency keys used to be unique across all buyers
+	if _, err := r.collection.Indexes().DropOne(ctx, "idempotency_key_unique"); err != nil && !indexNotFound(err) {
+		return err
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		// Keyset pagination sorts by (created_at, _id) within each filter
+		{
+			Keys:    bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("created_at_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "buyer_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("buyer_id_created_at_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "seller_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("seller_id_created_at_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "skin_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("skin_id_created_at_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "buyer_skin_ids", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("buyer_skin_ids_created_at_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "seller_skin_ids", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("seller_skin_ids_created_at_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("status_created_at_id"),
+		},
+		// Keys are generated by clients, so they only need to be unique per buyer
+		{
+			Keys: bson.D{{Key: "buyer_id", Value: 1}, {Key: "idempotency_key", Value: 1}},
+			Options: options.Index().
+				SetName("buyer_id_idempotency_key_unique").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"idempotency_key": bson.M{"$exists": true}}),
+		},
+		// The archiver looks for old terminal transactions
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "updated_at", Value: 1}},
+			Options: options.Index().SetName("status_updated_at"),
+		},
+	})
+	if err != nil {
+		return err
 	}
+
+	_, err = r.archive.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("created_at_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "buyer_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("buyer_id_created_at_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "seller_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("seller_id_created_at_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "skin_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("skin_id_created_at_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "buyer_skin_ids", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("buyer_skin_ids_created_at_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "seller_skin_ids", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("seller_skin_ids_created_at_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("status_created_at_id"),
+		},
+	})
+	return err
 }
 
 // CreateTransaction inserts a new transaction into the database
@@ -27,9 +144,13 @@ func (r *TransactionRepository) CreateTransaction(ctx context.Context, transacti
 	transaction.CreatedAt = now
 	transaction.UpdatedAt = now
 	transaction.Date = now.Format("2006-01-02 15:04:05")
+	transaction.Version = 1
 
 	result, err := r.collection.InsertOne(ctx, transaction)
 	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, models.ErrDuplicateIdempotencyKey
+		}
 		return nil, err
 	}
 
@@ -44,37 +165,170 @@ func (r *TransactionRepository) GetTransactionByID(ctx context.Context, id primi
 
 	err := r.collection.FindOne(ctx, filter).Decode(&transaction)
 	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrTransactionNotFound
+		}
+		return nil, err
+	}
+
+	return &transaction, nil
+}
+
+// GetTransactionByIdempotencyKey retrieves the transaction a buyer created with an unexpired idempotency key
+func (r *TransactionRepository) GetTransactionByIdempotencyKey(ctx context.Context, buyerID primitive.ObjectID, key string) (*models.Transaction, error) {
+	var transaction models.Transaction
+	filter := bson.M{
+		"buyer_id":               buyerID,
+		"idempotency_key":        key,
+		"idempotency_expires_at": bson.M{"$gt": time.Now()},
+	}
+
+	err := r.collection.FindOne(ctx, filter).Decode(&transaction)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrTransactionNotFound
+		}
 		return nil, err
 	}
 
 	return &transaction, nil
 }
 
-// UpdateTransaction updates a transaction
-func (r *TransactionRepository) UpdateTransaction(ctx context.Context, id primitive.ObjectID, update bson.M) (*models.Transaction, error) {
+// ReleaseExpiredIdempotencyKey removes a buyer's key from the transaction holding it once
+// the key has expired, so the unique index allows it to be used again
+func (r *TransactionRepository) ReleaseExpiredIdempotencyKey(ctx context.Context, buyerID primitive.ObjectID, key string) error {
+	filter := bson.M{
+		"buyer_id":               buyerID,
+		"idempotency_key":        key,
+		"idempotency_expires_at": bson.M{"$lte": time.Now()},
+	}
+	update := bson.M{"$unset": bson.M{
+		"idempotency_key":        "",
+		"request_hash":           "",
+		"idempotency_expires_at": "",
+	}}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// UpdateTransaction updates a transaction if its version still equals expectedVersion
+// and bumps the version, so concurrent writers cannot overwrite each other.
+// change is appended to the transaction's history and the fields in unset are
+// removed in the same write.
+func (r *TransactionRepository) UpdateTransaction(ctx context.Context, id primitive.ObjectID, expectedVersion int64, update bson.M, change models.StatusChange, unset ...string) (*models.Transaction, error) {
 	update["updated_at"] = time.Now()
 
-	filter := bson.M{"_id": id}
+	filter := bson.M{"_id": id, "version": expectedVersion}
+	if expectedVersion == 0 {
+		// Documents written before versioning have no version field
+		filter["version"] = bson.M{"$in": bson.A{0, nil}}
+	}
 	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
 
+	changes := bson.M{
+		"$set":  update,
+		"$inc":  bson.M{"version": 1},
+		"$push": bson.M{"history": change},
+	}
+	if len(unset) > 0 {
+		fields := bson.M{}
+		for _, field := range unset {
+			fields[field] = ""
+		}
+		changes["$unset"] = fields
+	}
+
 	var updatedTransaction models.Transaction
-	err := r.collection.FindOneAndUpdate(ctx, filter, bson.M{"$set": update}, opts).Decode(&updatedTransaction)
+	err := r.collection.FindOneAndUpdate(ctx, filter, changes, opts).Decode(&updatedTransaction)
 	if err != nil {
-		return nil, err
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, err
+		}
+
+		// Tell a missing transaction apart from a stale version
+		count, countErr := r.collection.CountDocuments(ctx, bson.M{"_id": id})
+		if countErr != nil {
+			return nil, countErr
+		}
+		if count == 0 {
+			return nil, models.ErrTransactionNotFound
+		}
+		return nil, models.ErrVersionConflict
 	}
 
 	return &updatedTransaction, nil
 }
 
-// DeleteTransaction removes a transaction from the database
-func (r *TransactionRepository) DeleteTransaction(ctx context.Context, id primitive.ObjectID) error {
-	filter := bson.M{"_id": id}
-	_, err := r.collection.DeleteOne(ctx, filter)
-	return err
+// GetArchivedTransactionByID retrieves a transaction from the archive
+func (r *TransactionRepository) GetArchivedTransactionByID(ctx context.Context, id primitive.ObjectID) (*models.Transaction, error) {
+	var transaction models.Transaction
+
+	err := r.archive.FindOne(ctx, bson.M{"_id": id}).Decode(&transaction)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrTransactionNotFound
+		}
+		return nil, err
+	}
+
+	return &transaction, nil
+}
+
+// ArchiveTransactions moves up to limit terminal transactions last updated
+// before cutoff into the archive and returns how many were moved. A transaction
+// is only removed if it did not change while being copied, so replicas can
+// archive concurrently and an interrupted run is simply picked up again.
+func (r *TransactionRepository) ArchiveTransactions(ctx context.Context, cutoff time.Time, limit int64) (int, error) {
+	filter := bson.M{
+		"status":     bson.M{"$in": models.TerminalStatuses},
+		"updated_at": bson.M{"$lt": cutoff},
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "updated_at", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	var transactions []models.Transaction
+	if err := cursor.All(ctx, &transactions); err != nil {
+		return 0, err
+	}
+
+	archived := 0
+	for i := range transactions {
+		t := &transactions[i]
+		t.Archived = true
+
+		// A copy left behind by an interrupted run is replaced
+		_, err := r.archive.ReplaceOne(ctx, bson.M{"_id": t.ID}, t, options.Replace().SetUpsert(true))
+		if err != nil {
+			return archived, err
+		}
+
+		result, err := r.collection.DeleteOne(ctx, bson.M{"_id": t.ID, "version": t.Version})
+		if err != nil {
+			return archived, err
+		}
+		if result.DeletedCount == 0 {
+			// Changed or already archived by another replica; drop the copy
+			// only if the transaction is still live
+			if count, _ := r.collection.CountDocuments(ctx, bson.M{"_id": t.ID}); count > 0 {
+				_, _ = r.archive.DeleteOne(ctx, bson.M{"_id": t.ID})
+			}
+			continue
+		}
+		archived++
+	}
+
+	return archived, nil
 }
 
 // GetTransactionsByUserID retrieves all transactions for a specific user (buyer or seller)
-func (r *TransactionRepository) GetTransactionsByUserID(ctx context.Context, userID primitive.ObjectID, status models.TransactionStatus, txType models.TransactionType, limit, offset int32) ([]models.Transaction, int64, error) {
+func (r *TransactionRepository) GetTransactionsByUserID(ctx context.Context, userID primitive.ObjectID, status models.TransactionStatus, txType models.TransactionType, page models.Page) ([]models.Transaction, int64, error) {
 	filter := bson.M{
 		"$or": []bson.M{
 			{"buyer_id": userID},
@@ -90,59 +344,319 @@ func (r *TransactionRepository) GetTransactionsByUserID(ctx context.Context, use
 		filter["type"] = txType
 	}
 
-	// Count total documents
-	totalCount, err := r.collection.CountDocuments(ctx, filter)
-	if err != nil {
-		return nil, 0, err
-	}
+	return r.findPage(ctx, filter, page)
+}
 
-	// Set up options for pagination
-	opts := options.Find().
-		SetSort(bson.D{{Key: "created_at", Value: -1}}) // Sort by newest first
+// GetTransactionsBySkinID retrieves all transactions of a specific skin,
+// including trades that gave or received it
+func (r *TransactionRepository) GetTransactionsBySkinID(ctx context.Context, skinID primitive.ObjectID, page models.Page) ([]models.Transaction, error) {
+	filter := bson.M{"$or": []bson.M{
+		{"skin_id": skinID},
+		{"buyer_skin_ids": skinID},
+		{"seller_skin_ids": skinID},
+	}}
 
-	if limit > 0 {
-		opts.SetLimit(int64(limit))
-	}
-	if offset > 0 {
-		opts.SetSkip(int64(offset))
+	transactions, _, err := r.findPage(ctx, filter, page)
+	return transactions, err
+}
+
+// GetTransactionsByStatus retrieves transactions filtered by status
+func (r *TransactionRepository) GetTransactionsByStatus(ctx context.Context, status models.TransactionStatus, page models.Page) ([]models.Transaction, int64, error) {
+	filter := bson.M{"status": status}
+
+	return r.findPage(ctx, filter, page)
+}
+
+// GetAllTransactions retrieves all transactions with pagination
+func (r *TransactionRepository) GetAllTransactions(ctx context.Context, page models.Page) ([]models.Transaction, int64, error) {
+	filter := bson.M{}
+
+	return r.findPage(ctx, filter, page)
+}
+
+// GetStalePendingTransactions retrieves up to limit transactions that have been
+// PENDING since before cutoff, or tradeCutoff for trades, oldest first
+func (r *TransactionRepository) GetStalePendingTransactions(ctx context.Context, cutoff, tradeCutoff time.Time, limit int64) ([]models.Transaction, error) {
+	filter := bson.M{
+		"status": models.StatusPending,
+		"$or": []bson.M{
+			{"type": bson.M{"$ne": models.TypeTrade}, "created_at": bson.M{"$lt": cutoff}},
+			{"type": models.TypeTrade, "created_at": bson.M{"$lt": tradeCutoff}},
+		},
 	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetLimit(limit)
 
 	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer cursor.Close(ctx)
 
 	var transactions []models.Transaction
 	if err := cursor.All(ctx, &transactions); err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
-	return transactions, totalCount, nil
+	return transactions, nil
 }
 
-// GetTransactionsBySkinID retrieves all transactions for a specific skin
-func (r *TransactionRepository) GetTransactionsBySkinID(ctx context.Context, skinID primitive.ObjectID) ([]models.Transaction, error) {
-	filter := bson.M{"skin_id": skinID}
-	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+// CountBuyerTransactionsSince counts the transactions a buyer created since a
+// time, whatever their status
+func (r *TransactionRepository) CountBuyerTransactionsSince(ctx context.Context, buyerID primitive.ObjectID, since time.Time) (int64, error) {
+	return r.collection.CountDocuments(ctx, bson.M{
+		"buyer_id":   buyerID,
+		"created_at": bson.M{"$gte": since},
+	})
+}
 
-	cursor, err := r.collection.Find(ctx, filter, opts)
+// CountPairTransactionsSince counts the completed transactions of a skin
+// between two users, in either direction, since a time
+func (r *TransactionRepository) CountPairTransactionsSince(ctx context.Context, skinID, userA, userB primitive.ObjectID, since time.Time) (int64, error) {
+	return r.collection.CountDocuments(ctx, bson.M{
+		"skin_id":    skinID,
+		"status":     models.StatusCompleted,
+		"created_at": bson.M{"$gte": since},
+		"$or": []bson.M{
+			{"buyer_id": userA, "seller_id": userB},
+			{"buyer_id": userB, "seller_id": userA},
+		},
+	})
+}
+
+// StreamTransactionsByUserID calls fn for each live or archived transaction of
+// a user created within [from, to), oldest first, decoding one document at a time
+func (r *TransactionRepository) StreamTransactionsByUserID(ctx context.Context, userID primitive.ObjectID, from, to *time.Time, fn func(*models.Transaction) error) error {
+	filter := bson.M{
+		"$or": []bson.M{
+			{"buyer_id": userID},
+			{"seller_id": userID},
+		},
+	}
+
+	createdAt := bson.M{}
+	if from != nil {
+		createdAt["$gte"] = *from
+	}
+	if to != nil {
+		createdAt["$lt"] = *to
+	}
+	if len(createdAt) > 0 {
+		filter["created_at"] = createdAt
+	}
+	filter = withoutDeleted(filter)
+
+	pipeline := []bson.M{
+		{"$match": filter},
+		r.unionArchive(filter),
+		{"$sort": bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer cursor.Close(ctx)
 
-	var transactions []models.Transaction
-	if err := cursor.All(ctx, &transactions); err != nil {
-		return nil, err
+	for cursor.Next(ctx) {
+		var transaction models.Transaction
+		if err := cursor.Decode(&transaction); err != nil {
+			return err
+		}
+		if err := fn(&transaction); err != nil {
+			return err
+		}
 	}
 
-	return transactions, nil
+	return cursor.Err()
 }
 
-// GetTransactionsByStatus retrieves transactions filtered by status
-func (r *TransactionRepository) GetTransactionsByStatus(ctx context.Context, status models.TransactionStatus, limit, offset int32) ([]models.Transaction, int64, error) {
-	filter := bson.M{"status": status}
+// StreamCompletedTransactions calls fn for each live or archived COMPLETED
+// transaction, oldest first. Deleted transactions are included since they
+// still moved funds and skins.
+func (r *TransactionRepository) StreamCompletedTransactions(ctx context.Context, fn func(*models.Transaction) error) error {
+	filter := bson.M{"status": models.StatusCompleted}
+
+	pipeline := []bson.M{
+		{"$match": filter},
+		r.unionArchive(filter),
+		{"$sort": bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var transaction models.Transaction
+		if err := cursor.Decode(&transaction); err != nil {
+			return err
+		}
+		if err := fn(&transaction); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+// TransactionChange is an insert or update of a live transaction, read from
+// the change stream
+type TransactionChange struct {
+	Token         string // resume token of the change
+	Inserted      bool
+	UpdatedFields []string             // top-level fields set by an update
+	StatusChange  *models.StatusChange // the history entry added by an update, if any
+	// Transaction is the document as looked up after the change, so it may
+	// already include later changes
+	Transaction models.Transaction
+}
+
+// changeStreamHistoryLost is the server error code for a resume token whose
+// changes have left the oplog
+const changeStreamHistoryLost = 286
+
+// Server error codes for dropping a missing index or an index of a missing collection
+const (
+	namespaceNotFoundCode = 26
+	indexNotFoundCode     = 27
+)
+
+// WatchTransactions calls fn for each insert and update of a live
+// transaction, in order, starting after the change with resume token after,
+// or from now on if after is empty. started is called with the token the
+// stream starts at before any change is delivered. It returns
+// ErrChangeHistoryLost if the changes after after are gone.
+func (r *TransactionRepository) WatchTransactions(ctx context.Context, after string, started func(token string), fn func(*TransactionChange) error) error {
+	pipeline := []bson.M{
+		{"$match": bson.M{"operationType": bson.M{"$in": bson.A{"insert", "update", "replace"}}}},
+	}
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if after != "" {
+		opts.SetResumeAfter(bson.M{"_data": after})
+	}
+
+	stream, err := r.collection.Watch(ctx, pipeline, opts)
+	if err != nil {
+		return changeStreamError(err)
+	}
+	defer stream.Close(ctx)
+
+	token, err := resumeTokenData(stream.ResumeToken())
+	if err != nil {
+		return err
+	}
+	started(token)
+
+	for stream.Next(ctx) {
+		var event struct {
+			OperationType     string              `bson:"operationType"`
+			FullDocument      *models.Transaction `bson:"fullDocument"`
+			UpdateDescription struct {
+				UpdatedFields bson.Raw `bson:"updatedFields"`
+			} `bson:"updateDescription"`
+		}
+		if err := stream.Decode(&event); err != nil {
+			return err
+		}
+		token, err := resumeTokenData(stream.ResumeToken())
+		if err != nil {
+			return err
+		}
+		// The transaction was archived before it could be looked up
+		if event.FullDocument == nil {
+			continue
+		}
+
+		change := &TransactionChange{
+			Token:       token,
+			Inserted:    event.OperationType == "insert",
+			Transaction: *event.FullDocument,
+		}
+		if err := change.readUpdatedFields(event.UpdateDescription.UpdatedFields); err != nil {
+			return err
+		}
+		if err := fn(change); err != nil {
+			return err
+		}
+	}
+
+	return changeStreamError(stream.Err())
+}
+
+// readUpdatedFields records the top-level fields of an update and the history
+// entry it pushed. A push shows as "history.<n>", or as the whole array when
+// it created the field.
+func (c *TransactionChange) readUpdatedFields(fields bson.Raw) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	elements, err := fields.Elements()
+	if err != nil {
+		return err
+	}
+
+	for _, element := range elements {
+		field, _, _ := strings.Cut(element.Key(), ".")
+		if !slices.Contains(c.UpdatedFields, field) {
+			c.UpdatedFields = append(c.UpdatedFields, field)
+		}
+		if field != "history" {
+			continue
+		}
+
+		var change models.StatusChange
+		if element.Key() == "history" {
+			var history []models.StatusChange
+			if err := element.Value().Unmarshal(&history); err != nil {
+				return err
+			}
+			if len(history) == 0 {
+				continue
+			}
+			change = history[len(history)-1]
+		} else if err := element.Value().Unmarshal(&change); err != nil {
+			return err
+		}
+		c.StatusChange = &change
+	}
+	return nil
+}
+
+func resumeTokenData(token bson.Raw) (string, error) {
+	data, ok := token.Lookup("_data").StringValueOK()
+	if !ok {
+		return "", errors.New("change stream resume token has no _data")
+	}
+	return data, nil
+}
+
+// indexNotFound reports whether err is from dropping an index, or an index of
+// a collection, that does not exist
+func indexNotFound(err error) bool {
+	var serverErr mongo.ServerError
+	return errors.As(err, &serverErr) && (serverErr.HasErrorCode(indexNotFoundCode) || serverErr.HasErrorCode(namespaceNotFoundCode))
+}
+
+func changeStreamError(err error) error {
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) && serverErr.HasErrorCode(changeStreamHistoryLost) {
+		return fmt.Errorf("%w: %v", models.ErrChangeHistoryLost, err)
+	}
+	return err
+}
+
+// findPage returns one page of the transactions matching filter, newest first,
+// along with the total number of matches. Pages after a cursor are found by
+// keyset on (created_at, _id) so rows inserted meanwhile cause no duplicates or gaps.
+func (r *TransactionRepository) findPage(ctx context.Context, filter bson.M, page models.Page) ([]models.Transaction, int64, error) {
+	filter = withoutDeleted(filter)
+	if page.IncludeArchived {
+		return r.findPageWithArchive(ctx, filter, page)
+	}
 
 	// Count total documents
 	totalCount, err := r.collection.CountDocuments(ctx, filter)
@@ -151,13 +665,16 @@ func (r *TransactionRepository) GetTransactionsByStatus(ctx context.Context, sta
 	}
 
 	opts := options.Find().
-		SetSort(bson.D{{Key: "created_at", Value: -1}})
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}) // Sort by newest first
 
-	if limit > 0 {
-		opts.SetLimit(int64(limit))
+	if page.Limit > 0 {
+		opts.SetLimit(int64(page.Limit))
 	}
-	if offset > 0 {
-		opts.SetSkip(int64(offset))
+
+	if page.After != nil {
+		filter = afterCursor(filter, page.After)
+	} else if page.Offset > 0 {
+		opts.SetSkip(int64(page.Offset))
 	}
 
 	cursor, err := r.collection.Find(ctx, filter, opts)
@@ -174,27 +691,48 @@ func (r *TransactionRepository) GetTransactionsByStatus(ctx context.Context, sta
 	return transactions, totalCount, nil
 }
 
-// GetAllTransactions retrieves all transactions with pagination
-func (r *TransactionRepository) GetAllTransactions(ctx context.Context, limit, offset int32) ([]models.Transaction, int64, error) {
-	filter := bson.M{}
+// findPageWithArchive is findPage over the live and the archived transactions
+func (r *TransactionRepository) findPageWithArchive(ctx context.Context, filter bson.M, page models.Page) ([]models.Transaction, int64, error) {
+	countPipeline := []bson.M{
+		{"$match": filter},
+		r.unionArchive(filter),
+		{"$count": "total"},
+	}
 
-	// Count total documents
-	totalCount, err := r.collection.CountDocuments(ctx, filter)
+	countCursor, err := r.collection.Aggregate(ctx, countPipeline)
 	if err != nil {
 		return nil, 0, err
 	}
+	defer countCursor.Close(ctx)
 
-	opts := options.Find().
-		SetSort(bson.D{{Key: "created_at", Value: -1}})
+	var totalCount int64
+	var counts []struct {
+		Total int64 `bson:"total"`
+	}
+	if err := countCursor.All(ctx, &counts); err != nil {
+		return nil, 0, err
+	}
+	if len(counts) > 0 {
+		totalCount = counts[0].Total
+	}
 
-	if limit > 0 {
-		opts.SetLimit(int64(limit))
+	if page.After != nil {
+		filter = afterCursor(filter, page.After)
+	}
+
+	pipeline := []bson.M{
+		{"$match": filter},
+		r.unionArchive(filter),
+		{"$sort": bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},
 	}
-	if offset > 0 {
-		opts.SetSkip(int64(offset))
+	if page.After == nil && page.Offset > 0 {
+		pipeline = append(pipeline, bson.M{"$skip": int64(page.Offset)})
+	}
+	if page.Limit > 0 {
+		pipeline = append(pipeline, bson.M{"$limit": int64(page.Limit)})
 	}
 
-	cursor, err := r.collection.Find(ctx, filter, opts)
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -208,9 +746,20 @@ func (r *TransactionRepository) GetAllTransactions(ctx context.Context, limit, o
 	return transactions, totalCount, nil
 }
 
-// GetTransactionStats calculates transaction statistics
-func (r *TransactionRepository) GetTransactionStats(ctx context.Context, userID *primitive.ObjectID, startDate, endDate string) (*TransactionStats, error) {
-	filter := bson.M{}
+// afterCursor restricts filter to the transactions sorted after cursor
+func afterCursor(filter bson.M, cursor *models.PageCursor) bson.M {
+	return bson.M{"$and": []bson.M{filter, {
+		"$or": []bson.M{
+			{"created_at": bson.M{"$lt": cursor.CreatedAt}},
+			{"created_at": cursor.CreatedAt, "_id": bson.M{"$lt": cursor.ID}},
+		},
+	}}}
+}
+
+// statsFilter selects the transactions in currency that statistics are
+// computed from
+func statsFilter(userID *primitive.ObjectID, startDate, endDate, currency string) bson.M {
+	filter := bson.M{"amount.currency": currency}
 
 	// Add user filter if provided
 	if userID != nil {
@@ -231,39 +780,47 @@ func (r *TransactionRepository) GetTransactionStats(ctx context.Context, userID
 		}
 		filter["date"] = dateFilter
 	}
+	return withoutDeleted(filter)
+}
 
-	// Aggregation pipeline for statistics
-	pipeline := []bson.M{
-		{"$match": filter},
-		{
-			"$group": bson.M{
-				"_id":                nil,
-				"total_transactions": bson.M{"$sum": 1},
-				"total_amount":       bson.M{"$sum": "$amount"},
-				"successful_transactions": bson.M{
-					"$sum": bson.M{
-						"$cond": []interface{}{
-							bson.M{"$eq": []interface{}{"$status", "COMPLETED"}},
-							1,
-							0,
-						},
-					},
-				},
-				"failed_transactions": bson.M{
-					"$sum": bson.M{
-						"$cond": []interface{}{
-							bson.M{"$in": []interface{}{"$status", []string{"FAILED", "CANCELLED"}}},
-							1,
-							0,
-						},
-					},
-				},
-				"average_amount": bson.M{"$avg": "$amount"},
+// countStatus is a $group accumulator counting the transactions in one of statuses
+func countStatus(statuses ...models.TransactionStatus) bson.M {
+	return bson.M{
+		"$sum": bson.M{
+			"$cond": []interface{}{
+				bson.M{"$in": []interface{}{"$status", statuses}},
+				1,
+				0,
 			},
 		},
 	}
+}
 
-	cursor, err := r.collection.Aggregate(ctx, pipeline)
+// GetTransactionStats calculates statistics of the transactions in currency.
+// Amounts are in minor units of the currency.
+func (r *TransactionRepository) GetTransactionStats(ctx context.Context, userID *primitive.ObjectID, startDate, endDate, currency string) (*TransactionStats, error) {
+	filter := statsFilter(userID, startDate, endDate, currency)
+
+	// Aggregation pipeline for statistics, including archived transactions
+	pipeline, err := r.groupWithPercentiles(ctx, []bson.M{
+		{"$match": filter},
+		r.unionArchive(filter),
+	}, bson.M{
+		"_id":                     nil,
+		"total_transactions":      bson.M{"$sum": 1},
+		"total_amount":            bson.M{"$sum": "$amount.units"},
+		"successful_transactions": countStatus(models.StatusCompleted),
+		"failed_transactions":     countStatus(models.StatusFailed),
+		"cancelled_transactions":  countStatus(models.StatusCancelled),
+		"pending_transactions":    countStatus(models.StatusPending),
+		"average_amount":          bson.M{"$avg": "$amount.units"},
+		"total_fee_revenue":       completedFees,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
 	if err != nil {
 		return nil, err
 	}
@@ -280,24 +837,447 @@ func (r *TransactionRepository) GetTransactionStats(ctx context.Context, userID
 	}
 
 	result := results[0]
+	percentiles := getPercentilesFromBSON(result, "amount_percentiles")
 	stats := &TransactionStats{
 		TotalTransactions:      getInt32FromBSON(result, "total_transactions"),
 		TotalAmount:            getFloat64FromBSON(result, "total_amount"),
 		SuccessfulTransactions: getInt32FromBSON(result, "successful_transactions"),
 		FailedTransactions:     getInt32FromBSON(result, "failed_transactions"),
+		CancelledTransactions:  getInt32FromBSON(result, "cancelled_transactions"),
+		PendingTransactions:    getInt32FromBSON(result, "pending_transactions"),
 		AverageAmount:          getFloat64FromBSON(result, "average_amount"),
+		MedianAmount:           percentiles[0],
+		P90Amount:              percentiles[1],
+		P99Amount:              percentiles[2],
+		TotalFeeRevenue:        getFloat64FromBSON(result, "total_fee_revenue"),
 	}
 
 	return stats, nil
 }
 
-// TransactionStats represents aggregated transaction statistics
+// TransactionStats represents aggregated transaction statistics, with amounts
+// in minor units
 type TransactionStats struct {
 	TotalTransactions      int32   `json:"total_transactions"`
 	TotalAmount            float64 `json:"total_amount"`
 	SuccessfulTransactions int32   `json:"successful_transactions"`
 	FailedTransactions     int32   `json:"failed_transactions"`
+	CancelledTransactions  int32   `json:"cancelled_transactions"`
+	PendingTransactions    int32   `json:"pending_transactions"`
 	AverageAmount          float64 `json:"average_amount"`
+	MedianAmount           float64 `json:"median_amount"`
+	P90Amount              float64 `json:"p90_amount"`
+	P99Amount              float64 `json:"p99_amount"`
+	TotalFeeRevenue        float64 `json:"total_fee_revenue"`
+}
+
+// percentileRanks are the percentiles of the amounts in the stats: the
+// median, 90th and 99th percentile
+var percentileRanks = bson.A{0.5, 0.9, 0.99}
+
+// amountPercentiles is a $group accumulator of the approximate percentile
+// amounts. It needs MongoDB 7.0 or later.
+var amountPercentiles = bson.M{"$percentile": bson.M{
+	"input":  "$amount.units",
+	"p":      percentileRanks,
+	"method": "approximate",
+}}
+
+// groupWithPercentiles appends the $group stage group to pipeline, adding the
+// percentile amounts as amount_percentiles. Servers older than MongoDB 7.0
+// lack $percentile, so there the amounts are sorted and collected per group
+// and the percentiles picked by nearest rank, which keeps each group's
+// amounts in memory on the server.
+func (r *TransactionRepository) groupWithPercentiles(ctx context.Context, pipeline []bson.M, group bson.M) ([]bson.M, error) {
+	native, err := r.supportsPercentile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if native {
+		group["amount_percentiles"] = amountPercentiles
+		return append(pipeline, bson.M{"$group": group}), nil
+	}
+
+	group["sorted_amounts"] = bson.M{"$push": "$amount.units"}
+	nearestRank := bson.M{"$max": bson.A{0, bson.M{"$subtract": bson.A{
+		bson.M{"$ceil": bson.M{"$multiply": bson.A{"$$p", bson.M{"$size": "$sorted_amounts"}}}},
+		1,
+	}}}}
+	return append(pipeline,
+		bson.M{"$sort": bson.M{"amount.units": 1}},
+		bson.M{"$group": group},
+		bson.M{"$set": bson.M{"amount_percentiles": bson.M{"$map": bson.M{
+			"input": percentileRanks,
+			"as":    "p",
+			"in":    bson.M{"$arrayElemAt": bson.A{"$sorted_amounts", bson.M{"$toInt": nearestRank}}},
+		}}}},
+		bson.M{"$unset": "sorted_amounts"},
+	), nil
+}
+
+// percentileVersion is the first MongoDB major version with $percentile
+const percentileVersion = 7
+
+// supportsPercentile reports whether the server has the $percentile
+// accumulator. The server is asked once.
+func (r *TransactionRepository) supportsPercentile(ctx context.Context) (bool, error) {
+	r.percentileMu.Lock()
+	defer r.percentileMu.Unlock()
+
+	if r.percentile != nil {
+		return *r.percentile, nil
+	}
+
+	var info struct {
+		VersionArray []int32 `bson:"versionArray"`
+	}
+	err := r.collection.Database().RunCommand(ctx, bson.D{{Key: "buildInfo", Value: 1}}).Decode(&info)
+	if err != nil {
+		return false, fmt.Errorf("failed to get server version: %w", err)
+	}
+
+	supported := len(info.VersionArray) > 0 && info.VersionArray[0] >= percentileVersion
+	r.percentile = &supported
+	return supported, nil
+}
+
+// completedFees is a $group accumulator of the fees of completed transactions
+var completedFees = bson.M{
+	"$sum": bson.M{
+		"$cond": []interface{}{
+			bson.M{"$eq": []interface{}{"$status", models.StatusCompleted}},
+			bson.M{"$ifNull": []interface{}{"$fee_amount.units", 0}},
+			0,
+		},
+	},
+}
+
+// StatsGrouping selects the dimensions GetGroupedTransactionStats groups by
+type StatsGrouping struct {
+	Type   bool
+	Status bool
+	Role   *primitive.ObjectID // group by the side this user took
+	Rarity bool
+	// Rarities of the skins of transactions created before the rarity was
+	// stored on them. Skins missing here are "unknown".
+	Rarities map[primitive.ObjectID]string
+}
+
+// StatsGroup holds the statistics of the transactions sharing the values of
+// the requested dimensions, with amounts in minor units
+type StatsGroup struct {
+	Key struct {
+		Type   string `bson:"type"`
+		Status string `bson:"status"`
+		Role   string `bson:"role"`
+		Rarity string `bson:"rarity"`
+	} `bson:"_id"`
+	TotalTransactions int32     `bson:"total_transactions"`
+	TotalAmount       float64   `bson:"total_amount"`
+	AverageAmount     float64   `bson:"average_amount"`
+	Percentiles       []float64 `bson:"amount_percentiles"`
+	TotalFeeRevenue   float64   `bson:"total_fee_revenue"`
+}
+
+// MedianAmount returns the median amount of the group
+func (g *StatsGroup) MedianAmount() float64 {
+	if len(g.Percentiles) == 0 {
+		return 0
+	}
+	return g.Percentiles[0]
+}
+
+// GetGroupedTransactionStats calculates the statistics of the transactions
+// counted by GetTransactionStats with the same arguments, for each
+// combination of the values of the dimensions in grouping
+func (r *TransactionRepository) GetGroupedTransactionStats(ctx context.Context, userID *primitive.ObjectID, startDate, endDate, currency string, grouping StatsGrouping) ([]StatsGroup, error) {
+	filter := statsFilter(userID, startDate, endDate, currency)
+
+	key := bson.M{}
+	if grouping.Type {
+		key["type"] = "$type"
+	}
+	if grouping.Status {
+		key["status"] = "$status"
+	}
+	if grouping.Role != nil {
+		key["role"] = bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$buyer_id", *grouping.Role}}, "buyer", "seller"}}
+	}
+	if grouping.Rarity {
+		key["rarity"] = rarityExpression(grouping.Rarities)
+	}
+
+	pipeline, err := r.groupWithPercentiles(ctx, []bson.M{
+		{"$match": filter},
+		r.unionArchive(filter),
+	}, bson.M{
+		"_id":                key,
+		"total_transactions": bson.M{"$sum": 1},
+		"total_amount":       bson.M{"$sum": "$amount.units"},
+		"average_amount":     bson.M{"$avg": "$amount.units"},
+		"total_fee_revenue":  completedFees,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var groups []StatsGroup
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// rarityExpression evaluates to the stored rarity of a transaction, or else
+// the rarity of its skin in rarities. Trades have no single skin and get no
+// rarity.
+func rarityExpression(rarities map[primitive.ObjectID]string) bson.M {
+	skinIDs := make(bson.A, 0, len(rarities))
+	values := make(bson.A, 0, len(rarities))
+	for skinID, rarity := range rarities {
+		skinIDs = append(skinIDs, skinID)
+		values = append(values, rarity)
+	}
+
+	lookup := bson.M{"$let": bson.M{
+		"vars": bson.M{"i": bson.M{"$indexOfArray": bson.A{bson.M{"$literal": skinIDs}, "$skin_id"}}},
+		"in": bson.M{"$cond": bson.A{
+			bson.M{"$gte": bson.A{"$$i", 0}},
+			bson.M{"$arrayElemAt": bson.A{bson.M{"$literal": values}, "$$i"}},
+			"unknown",
+		}},
+	}}
+
+	return bson.M{"$cond": bson.A{
+		bson.M{"$eq": bson.A{"$skin_id", primitive.NilObjectID}},
+		"",
+		bson.M{"$ifNull": bson.A{"$rarity", lookup}},
+	}}
+}
+
+// GetSkinsWithoutRarity returns the skins of the transactions counted by
+// GetTransactionStats with the same arguments that have no stored rarity
+func (r *TransactionRepository) GetSkinsWithoutRarity(ctx context.Context, userID *primitive.ObjectID, startDate, endDate, currency string) ([]primitive.ObjectID, error) {
+	filter := bson.M{"$and": []bson.M{
+		statsFilter(userID, startDate, endDate, currency),
+		{"rarity": bson.M{"$exists": false}, "skin_id": bson.M{"$ne": primitive.NilObjectID}},
+	}}
+
+	pipeline := []bson.M{
+		{"$match": filter},
+		r.unionArchive(filter),
+		{"$group": bson.M{"_id": "$skin_id"}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var skins []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &skins); err != nil {
+		return nil, err
+	}
+
+	skinIDs := make([]primitive.ObjectID, len(skins))
+	for i := range skins {
+		skinIDs[i] = skins[i].ID
+	}
+	return skinIDs, nil
+}
+
+// VolumeSeriesFilter selects the transactions bucketed by GetTransactionVolumeSeries.
+// Unit is a $dateTrunc unit: hour, day, week or month. Only transactions in
+// Currency are counted.
+type VolumeSeriesFilter struct {
+	Unit     string
+	Currency string
+	Timezone string
+	From     *time.Time
+	To       *time.Time
+	UserID   *primitive.ObjectID
+	SkinID   *primitive.ObjectID
+	Status   *models.TransactionStatus
+}
+
+// VolumeBucket aggregates the transactions created within one time bucket,
+// with amounts in minor units
+type VolumeBucket struct {
+	Start          time.Time `bson:"_id"`
+	Count          int32     `bson:"count"`
+	TotalAmount    float64   `bson:"total_amount"`
+	AverageAmount  float64   `bson:"average_amount"`
+	CompletedCount int32     `bson:"completed_count"`
+}
+
+// GetTransactionVolumeSeries groups transactions into time buckets over created_at
+func (r *TransactionRepository) GetTransactionVolumeSeries(ctx context.Context, f VolumeSeriesFilter) ([]VolumeBucket, error) {
+	filter := bson.M{"amount.currency": f.Currency}
+
+	if f.UserID != nil {
+		filter["$or"] = []bson.M{
+			{"buyer_id": *f.UserID},
+			{"seller_id": *f.UserID},
+		}
+	}
+	if f.SkinID != nil {
+		filter["skin_id"] = *f.SkinID
+	}
+	if f.Status != nil {
+		filter["status"] = *f.Status
+	}
+
+	createdAt := bson.M{}
+	if f.From != nil {
+		createdAt["$gte"] = *f.From
+	}
+	if f.To != nil {
+		createdAt["$lt"] = *f.To
+	}
+	if len(createdAt) > 0 {
+		filter["created_at"] = createdAt
+	}
+
+	trunc := bson.M{
+		"date":     "$created_at",
+		"unit":     f.Unit,
+		"timezone": f.Timezone,
+	}
+	if f.Unit == "week" {
+		trunc["startOfWeek"] = "monday"
+	}
+	filter = withoutDeleted(filter)
+
+	pipeline := []bson.M{
+		{"$match": filter},
+		r.unionArchive(filter),
+		{
+			"$group": bson.M{
+				"_id":            bson.M{"$dateTrunc": trunc},
+				"count":          bson.M{"$sum": 1},
+				"total_amount":   bson.M{"$sum": "$amount.units"},
+				"average_amount": bson.M{"$avg": "$amount.units"},
+				"completed_count": bson.M{
+					"$sum": bson.M{
+						"$cond": []interface{}{
+							bson.M{"$eq": []interface{}{"$status", "COMPLETED"}},
+							1,
+							0,
+						},
+					},
+				},
+			},
+		},
+		{"$sort": bson.M{"_id": 1}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var buckets []VolumeBucket
+	if err := cursor.All(ctx, &buckets); err != nil {
+		return nil, err
+	}
+
+	return buckets, nil
+}
+
+// RankTradersFilter selects the completed transactions traders are ranked on
+type RankTradersFilter struct {
+	Metric   models.LeaderboardMetric
+	Currency string
+	Since    *time.Time
+}
+
+// RankTraders calls fn for every user who bought or sold in f.Currency since
+// f.Since, best first by f.Metric. Refunds count toward profit only: the buyer
+// gets the amount back and the seller gives up the proceeds.
+func (r *TransactionRepository) RankTraders(ctx context.Context, f RankTradersFilter, fn func(*models.TraderStats) error) error {
+	filter := bson.M{
+		"status":          models.StatusCompleted,
+		"amount.currency": f.Currency,
+	}
+	if f.Since != nil {
+		filter["created_at"] = bson.M{"$gte": *f.Since}
+	}
+	filter = withoutDeleted(filter)
+
+	isRefund := bson.M{"$eq": bson.A{"$type", models.TypeRefund}}
+	// Transactions from before fees were withheld paid the whole amount to the seller
+	proceeds := bson.M{"$cond": bson.A{
+		bson.M{"$and": bson.A{
+			bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$fee_amount.units", 0}}, 0}},
+			bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$seller_proceeds.units", 0}}, 0}},
+		}},
+		"$amount.units",
+		"$seller_proceeds.units",
+	}}
+	traded := func(value interface{}) bson.M {
+		return bson.M{"$cond": bson.A{isRefund, 0, value}}
+	}
+
+	pipeline := []bson.M{
+		{"$match": filter},
+		r.unionArchive(filter),
+		{"$project": bson.M{"sides": bson.A{
+			bson.M{
+				"user_id":      "$buyer_id",
+				"volume":       traded("$amount.units"),
+				"transactions": traded(1),
+				"profit": bson.M{"$cond": bson.A{
+					isRefund, "$amount.units", bson.M{"$multiply": bson.A{"$amount.units", -1}},
+				}},
+			},
+			bson.M{
+				"user_id":      "$seller_id",
+				"volume":       traded("$amount.units"),
+				"transactions": traded(1),
+				"profit":       proceeds,
+			},
+		}}},
+		{"$unwind": "$sides"},
+		// Transactions without a seller have no seller side
+		{"$match": bson.M{"sides.user_id": bson.M{"$exists": true, "$ne": primitive.NilObjectID}}},
+		{"$group": bson.M{
+			"_id":          "$sides.user_id",
+			"volume":       bson.M{"$sum": "$sides.volume"},
+			"transactions": bson.M{"$sum": "$sides.transactions"},
+			"profit":       bson.M{"$sum": "$sides.profit"},
+		}},
+		// Refund-only traders have no volume to rank on
+		{"$match": bson.M{"transactions": bson.M{"$gt": 0}}},
+		{"$sort": bson.D{{Key: string(f.Metric), Value: -1}, {Key: "_id", Value: 1}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var stats models.TraderStats
+		if err := cursor.Decode(&stats); err != nil {
+			return err
+		}
+		if err := fn(&stats); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
 }
 
 // Helper functions to safely extract values from BSON
@@ -313,6 +1293,17 @@ func getInt32FromBSON(data bson.M, key string) int32 {
 	return 0
 }
 
+// getPercentilesFromBSON returns the three values of an amountPercentiles
+// accumulator, or zeros
+func getPercentilesFromBSON(data bson.M, key string) [3]float64 {
+	var percentiles [3]float64
+	values, _ := data[key].(bson.A)
+	for i := 0; i < len(values) && i < len(percentiles); i++ {
+		percentiles[i] = getFloat64FromBSON(bson.M{"value": values[i]}, "value")
+	}
+	return percentiles
+}
+
 func getFloat64FromBSON(data bson.M, key string) float64 {
 	if val, ok := data[key]; ok {
 		if floatVal, ok := val.(float64); ok {
@@ -324,6 +1315,9 @@ func getFloat64FromBSON(data bson.M, key string) float64 {
 		if intVal, ok := val.(int32); ok {
 			return float64(intVal)
 		}
+		if intVal, ok := val.(int64); ok {
+			return float64(intVal)
+		}
 	}
 	return 0.0
 }
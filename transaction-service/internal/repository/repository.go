@@ -4,6 +4,7 @@ import (
 	"context"
 	"cs2-marketplace-microservices/transaction-service/internal/models"
 	"cs2-marketplace-microservices/transaction-service/internal/repository/mongo"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -12,21 +13,70 @@ import (
 type TransactionRepository interface {
 	CreateTransaction(ctx context.Context, transaction *models.Transaction) (*models.Transaction, error)
 	GetTransactionByID(ctx context.Context, id primitive.ObjectID) (*models.Transaction, error)
-	UpdateTransaction(ctx context.Context, id primitive.ObjectID, update bson.M) (*models.Transaction, error)
-	DeleteTransaction(ctx context.Context, id primitive.ObjectID) error
-	GetTransactionsByUserID(ctx context.Context, userID primitive.ObjectID, status models.TransactionStatus, txType models.TransactionType, limit, offset int32) ([]models.Transaction, int64, error)
-	GetTransactionsBySkinID(ctx context.Context, skinID primitive.ObjectID) ([]models.Transaction, error)
-	GetTransactionsByStatus(ctx context.Context, status models.TransactionStatus, limit, offset int32) ([]models.Transaction, int64, error)
-	GetAllTransactions(ctx context.Context, limit, offset int32) ([]models.Transaction, int64, error)
-	GetTransactionStats(ctx context.Context, userID *primitive.ObjectID, startDate, endDate string) (*mongo.TransactionStats, error)
+	GetTransactionByIdempotencyKey(ctx context.Context, buyerID primitive.ObjectID, key string) (*models.Transaction, error)
+	ReleaseExpiredIdempotencyKey(ctx context.Context, buyerID primitive.ObjectID, key string) error
+	GetArchivedTransactionByID(ctx context.Context, id primitive.ObjectID) (*models.Transaction, error)
+	UpdateTransaction(ctx context.Context, id primitive.ObjectID, expectedVersion int64, update bson.M, change models.StatusChange, unset ...string) (*models.Transaction, error)
+	GetTransactionsByUserID(ctx context.Context, userID primitive.ObjectID, status models.TransactionStatus, txType models.TransactionType, page models.Page) ([]models.Transaction, int64, error)
+	GetTransactionsBySkinID(ctx context.Context, skinID primitive.ObjectID, page models.Page) ([]models.Transaction, error)
+	GetTransactionsByStatus(ctx context.Context, status models.TransactionStatus, page models.Page) ([]models.Transaction, int64, error)
+	GetAllTransactions(ctx context.Context, page models.Page) ([]models.Transaction, int64, error)
+	GetStalePendingTransactions(ctx context.Context, cutoff, tradeCutoff time.Time, limit int64) ([]models.Transaction, error)
+	CountBuyerTransactionsSince(ctx context.Context, buyerID primitive.ObjectID, since time.Time) (int64, error)
+	CountPairTransactionsSince(ctx context.Context, skinID, userA, userB primitive.ObjectID, since time.Time) (int64, error)
+	ArchiveTransactions(ctx context.Context, cutoff time.Time, limit int64) (int, error)
+	StreamTransactionsByUserID(ctx context.Context, userID primitive.ObjectID, from, to *time.Time, fn func(*models.Transaction) error) error
+	StreamCompletedTransactions(ctx context.Context, fn func(*models.Transaction) error) error
+	WatchTransactions(ctx context.Context, after string, started func(token string), fn func(*mongo.TransactionChange) error) error
+	GetTransactionStats(ctx context.Context, userID *primitive.ObjectID, startDate, endDate, currency string) (*mongo.TransactionStats, error)
+	GetGroupedTransactionStats(ctx context.Context, userID *primitive.ObjectID, startDate, endDate, currency string, grouping mongo.StatsGrouping) ([]mongo.StatsGroup, error)
+	GetSkinsWithoutRarity(ctx context.Context, userID *primitive.ObjectID, startDate, endDate, currency string) ([]primitive.ObjectID, error)
+	GetTransactionVolumeSeries(ctx context.Context, filter mongo.VolumeSeriesFilter) ([]mongo.VolumeBucket, error)
+	RankTraders(ctx context.Context, filter mongo.RankTradersFilter, fn func(*models.TraderStats) error) error
+}
+
+type DisputeRepository interface {
+	CreateDispute(ctx context.Context, dispute *models.Dispute) (*models.Dispute, error)
+	GetDisputeByID(ctx context.Context, id primitive.ObjectID) (*models.Dispute, error)
+	ListDisputes(ctx context.Context, status *models.DisputeStatus, limit, offset int32) ([]models.Dispute, int64, error)
+	UpdateDisputeStatus(ctx context.Context, id primitive.ObjectID, from models.DisputeStatus, update bson.M, unset ...string) (*models.Dispute, error)
+}
+
+type PriceHistoryRepository interface {
+	RecordSale(ctx context.Context, sale models.Sale) error
+	ReplaceCandles(ctx context.Context, candles []models.Candle) error
+	GetCandles(ctx context.Context, skinName, condition, currency string, interval models.CandleInterval, from, to *time.Time) ([]models.Candle, error)
+}
+
+type OrderRepository interface {
+	CreateOrder(ctx context.Context, order *models.Order) (*models.Order, error)
+	SaveOrder(ctx context.Context, order *models.Order) error
+}
+
+type ReceiptRepository interface {
+	CreateReceipt(ctx context.Context, receipt *models.Receipt) (*models.Receipt, error)
+	GetReceiptByTransactionID(ctx context.Context, transactionID primitive.ObjectID) (*models.Receipt, error)
+	MarkReceiptEmailed(ctx context.Context, id primitive.ObjectID, at time.Time) error
+}
+
+type RepairTaskRepository interface {
+	OpenRepairTask(ctx context.Context, d models.Discrepancy) (bool, error)
 }
 
 type Repositories struct {
-	Transaction TransactionRepository
+	Transaction  TransactionRepository
+	Dispute      DisputeRepository
+	PriceHistory PriceHistoryRepository
+	Order        OrderRepository
+	Receipt      ReceiptRepository
 }
 
-func NewRepositories(transactionRepo TransactionRepository) *Repositories {
+func NewRepositories(transactionRepo TransactionRepository, disputeRepo DisputeRepository, priceHistoryRepo PriceHistoryRepository, orderRepo OrderRepository, receiptRepo ReceiptRepository) *Repositories {
 	return &Repositories{
-		Transaction: transactionRepo,
+		Transaction:  transactionRepo,
+		Dispute:      disputeRepo,
+		PriceHistory: priceHistoryRepo,
+		Order:        orderRepo,
+		Receipt:      receiptRepo,
 	}
 }
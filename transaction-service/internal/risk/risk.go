@@ -0,0 +1,102 @@
+package risk
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Input describes the transaction being assessed. SkinName and Condition may
+// be empty if the caller has not looked up the skin.
+type Input struct {
+	BuyerID   primitive.ObjectID
+	SellerID  primitive.ObjectID
+	SkinID    primitive.ObjectID
+	SkinName  string
+	Condition string
+	Amount    models.Money
+	Now       time.Time
+}
+
+// Data gives rules access to the history of the parties and the skin
+type Data interface {
+	// CountBuyerTransactions counts the transactions created by a buyer since a time
+	CountBuyerTransactions(ctx context.Context, buyerID primitive.ObjectID, since time.Time) (int64, error)
+	// CountPairTransactions counts the completed transactions of a skin between
+	// two users, in either direction, since a time
+	CountPairTransactions(ctx context.Context, skinID, userA, userB primitive.ObjectID, since time.Time) (int64, error)
+	// AveragePrice returns the average sale price of the skin since a time in
+	// the given currency, and false if it has not been sold
+	AveragePrice(ctx context.Context, in Input, currency string, since time.Time) (models.Money, bool, error)
+	// AccountCreatedAt returns when a user registered
+	AccountCreatedAt(ctx context.Context, userID primitive.ObjectID) (time.Time, error)
+}
+
+// Rule is one risk check. It returns RiskAllow if it does not apply, or the
+// decision it configured with the reason it triggered.
+type Rule interface {
+	Name() string
+	Evaluate(ctx context.Context, in Input, data Data) (models.RiskDecision, string, error)
+}
+
+// Chain runs risk rules in order. A nil chain allows everything.
+type Chain struct {
+	rules []Rule
+}
+
+func NewChain(rules ...Rule) *Chain {
+	return &Chain{rules: rules}
+}
+
+// Evaluate runs every rule and returns the most severe decision. Rules stop
+// running once one blocks. A rule that fails is treated as a review, so an
+// outage of its data never lets a transaction through unflagged.
+func (c *Chain) Evaluate(ctx context.Context, in Input, data Data) models.RiskAssessment {
+	assessment := models.RiskAssessment{Decision: models.RiskAllow}
+	if c == nil {
+		return assessment
+	}
+	if in.Now.IsZero() {
+		in.Now = time.Now()
+	}
+
+	for _, rule := range c.rules {
+		decision, reason, err := rule.Evaluate(ctx, in, data)
+		if err != nil {
+			log.Printf("Risk rule %s failed: %v", rule.Name(), err)
+			decision, reason = models.RiskReview, fmt.Sprintf("%s check failed", rule.Name())
+		}
+		if decision == models.RiskAllow {
+			continue
+		}
+
+		assessment.Findings = append(assessment.Findings, models.RiskFinding{
+			Rule:     rule.Name(),
+			Decision: decision,
+			Reason:   reason,
+		})
+		if decision.Severity() > assessment.Decision.Severity() {
+			assessment.Decision = decision
+		}
+		if decision == models.RiskBlock {
+			break
+		}
+	}
+
+	return assessment
+}
+
+// ParseDecision parses a configured decision, e.g. "review"
+func ParseDecision(s string) (models.RiskDecision, error) {
+	switch d := models.RiskDecision(strings.ToUpper(strings.TrimSpace(s))); d {
+	case models.RiskAllow, models.RiskReview, models.RiskBlock:
+		return d, nil
+	default:
+		return "", fmt.Errorf("unknown risk decision %q", s)
+	}
+}
@@ -0,0 +1,118 @@
+package risk
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Rule names, as used in the configured chain
+const (
+	RuleVelocity   = "velocity"
+	RulePingPong   = "ping_pong"
+	RulePriceSpike = "price_spike"
+	RuleNewAccount = "new_account"
+)
+
+// Velocity triggers when a buyer has created Max or more transactions within Window
+type Velocity struct {
+	Window   time.Duration
+	Max      int64
+	Decision models.RiskDecision
+}
+
+func (r Velocity) Name() string { return RuleVelocity }
+
+func (r Velocity) Evaluate(ctx context.Context, in Input, data Data) (models.RiskDecision, string, error) {
+	count, err := data.CountBuyerTransactions(ctx, in.BuyerID, in.Now.Add(-r.Window))
+	if err != nil {
+		return "", "", err
+	}
+	if count < r.Max {
+		return models.RiskAllow, "", nil
+	}
+	return r.Decision, fmt.Sprintf("buyer created %d transactions in the last %s", count, r.Window), nil
+}
+
+// PingPong triggers when the same skin has already changed hands between the
+// buyer and the seller Max or more times within Window, a sign of wash trading
+type PingPong struct {
+	Window   time.Duration
+	Max      int64
+	Decision models.RiskDecision
+}
+
+func (r PingPong) Name() string { return RulePingPong }
+
+func (r PingPong) Evaluate(ctx context.Context, in Input, data Data) (models.RiskDecision, string, error) {
+	if in.SellerID.IsZero() || in.SkinID.IsZero() {
+		return models.RiskAllow, "", nil
+	}
+
+	count, err := data.CountPairTransactions(ctx, in.SkinID, in.BuyerID, in.SellerID, in.Now.Add(-r.Window))
+	if err != nil {
+		return "", "", err
+	}
+	if count < r.Max {
+		return models.RiskAllow, "", nil
+	}
+	return r.Decision, fmt.Sprintf("skin changed hands between buyer and seller %d times in the last %s", count, r.Window), nil
+}
+
+// PriceSpike triggers when the amount is more than Multiplier times the
+// average sale price of the skin within Window. Skins without recent sales pass.
+type PriceSpike struct {
+	Window     time.Duration
+	Multiplier float64
+	Decision   models.RiskDecision
+}
+
+func (r PriceSpike) Name() string { return RulePriceSpike }
+
+func (r PriceSpike) Evaluate(ctx context.Context, in Input, data Data) (models.RiskDecision, string, error) {
+	if in.SkinID.IsZero() {
+		return models.RiskAllow, "", nil
+	}
+
+	average, found, err := data.AveragePrice(ctx, in, in.Amount.Currency, in.Now.Add(-r.Window))
+	if err != nil {
+		return "", "", err
+	}
+	if !found || average.Units <= 0 || float64(in.Amount.Units) <= float64(average.Units)*r.Multiplier {
+		return models.RiskAllow, "", nil
+	}
+	return r.Decision, fmt.Sprintf("amount %s is more than %gx the recent average price %s", in.Amount, r.Multiplier, average), nil
+}
+
+// NewAccount triggers when the buyer or the seller registered less than MinAge ago
+type NewAccount struct {
+	MinAge   time.Duration
+	Decision models.RiskDecision
+}
+
+func (r NewAccount) Name() string { return RuleNewAccount }
+
+func (r NewAccount) Evaluate(ctx context.Context, in Input, data Data) (models.RiskDecision, string, error) {
+	parties := []struct {
+		role string
+		id   primitive.ObjectID
+	}{{"buyer", in.BuyerID}, {"seller", in.SellerID}}
+
+	for _, party := range parties {
+		if party.id.IsZero() {
+			continue
+		}
+
+		createdAt, err := data.AccountCreatedAt(ctx, party.id)
+		if err != nil {
+			return "", "", err
+		}
+		if age := in.Now.Sub(createdAt); age < r.MinAge {
+			return r.Decision, fmt.Sprintf("%s account is only %s old", party.role, age.Round(time.Minute)), nil
+		}
+	}
+	return models.RiskAllow, "", nil
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/cache"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/internal/repository/mongo"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// bucketUnits maps bucket intervals to $dateTrunc units
+var bucketUnits = map[transaction.BucketInterval]string{
+	transaction.BucketInterval_HOUR:  "hour",
+	transaction.BucketInterval_DAY:   "day",
+	transaction.BucketInterval_WEEK:  "week",
+	transaction.BucketInterval_MONTH: "month",
+}
+
+// GetTransactionVolumeSeries returns transaction volume per time bucket over created_at
+func (uc *transactionUsecase) GetTransactionVolumeSeries(ctx context.Context, req *transaction.GetTransactionVolumeSeriesRequest) (*transaction.TransactionVolumeSeriesResponse, error) {
+	unit, ok := bucketUnits[req.GetInterval()]
+	if !ok {
+		return nil, fmt.Errorf("unsupported interval: %v", req.GetInterval())
+	}
+
+	timezone := req.GetTimezone()
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %v", err)
+	}
+
+	currency, err := uc.reportCurrency(req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := mongo.VolumeSeriesFilter{
+		Unit:     unit,
+		Currency: currency,
+		Timezone: timezone,
+	}
+
+	filter.From, filter.To, err = parseTimeRange(req.GetStartTime(), req.GetEndTime())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetUserId() != "" {
+		userID, err := primitive.ObjectIDFromHex(req.GetUserId())
+		if err != nil {
+			return nil, fmt.Errorf("invalid user_id: %v", err)
+		}
+		filter.UserID = &userID
+	}
+	if req.GetSkinId() != "" {
+		skinID, err := primitive.ObjectIDFromHex(req.GetSkinId())
+		if err != nil {
+			return nil, fmt.Errorf("invalid skin_id: %v", err)
+		}
+		filter.SkinID = &skinID
+	}
+	if req.Status != nil {
+		status := models.StatusFromProto(req.GetStatus())
+		filter.Status = &status
+	}
+
+	cacheKey := uc.getListCacheKey(statsCachePrefix+"series:", unit, currency, timezone, req.GetStartTime(), req.GetEndTime(),
+		req.GetUserId(), req.GetSkinId(), filter.Status != nil, req.GetStatus())
+	if cached, found := uc.cache.Get(cacheKey); found {
+		if response, ok := cached.(*transaction.TransactionVolumeSeriesResponse); ok {
+			return response, nil
+		}
+	}
+
+	buckets, err := uc.transactionRepo.GetTransactionVolumeSeries(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction volume series: %v", err)
+	}
+
+	response := &transaction.TransactionVolumeSeriesResponse{
+		Buckets:  make([]*transaction.VolumeBucket, 0, len(buckets)),
+		Currency: currency,
+	}
+	for _, b := range buckets {
+		var completionRate float64
+		if b.Count > 0 {
+			completionRate = float64(b.CompletedCount) / float64(b.Count)
+		}
+
+		response.Buckets = append(response.Buckets, &transaction.VolumeBucket{
+			BucketStart:    b.Start.In(loc).Format(time.RFC3339),
+			Count:          b.Count,
+			TotalAmount:    models.MajorUnits(b.TotalAmount, currency),
+			AverageAmount:  models.MajorUnits(b.AverageAmount, currency),
+			CompletionRate: completionRate,
+		})
+	}
+
+	tag := cache.AllTag
+	switch {
+	case filter.UserID != nil:
+		tag = cache.UserTag(filter.UserID.Hex())
+	case filter.SkinID != nil:
+		tag = cache.SkinTag(filter.SkinID.Hex())
+	}
+	uc.cache.Set(cacheKey, response, statsCacheTTL, tag)
+
+	return response, nil
+}
+
+// reportCurrency returns the currency stats are reported in, which defaults to
+// the marketplace currency. Amounts in other currencies are not included.
+func (uc *transactionUsecase) reportCurrency(currency string) (string, error) {
+	if currency == "" {
+		return uc.currency, nil
+	}
+
+	currency = strings.ToUpper(currency)
+	if !models.ValidCurrency(currency) {
+		return "", fmt.Errorf("unsupported currency %q", currency)
+	}
+	return currency, nil
+}
+
+// parseTimeRange parses optional RFC3339 bounds; empty bounds yield nil
+func parseTimeRange(start, end string) (from, to *time.Time, err error) {
+	if start != "" {
+		t, err := time.Parse(time.RFC3339, start)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid start_time: %v", err)
+		}
+		from = &t
+	}
+	if end != "" {
+		t, err := time.Parse(time.RFC3339, end)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid end_time: %v", err)
+		}
+		to = &t
+	}
+	return from, to, nil
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// archiveBatchSize is the number of transactions moved to the archive at a time
+const archiveBatchSize = 500
+
+// ArchiveTransactions moves the terminal transactions last updated more than
+// age ago into the archive and returns how many were moved
+func (uc *transactionUsecase) ArchiveTransactions(ctx context.Context, age time.Duration) (int, error) {
+	cutoff := time.Now().Add(-age)
+	archived := 0
+
+	for {
+		moved, err := uc.transactionRepo.ArchiveTransactions(ctx, cutoff, archiveBatchSize)
+		archived += moved
+		if err != nil {
+			return archived, fmt.Errorf("failed to archive transactions: %v", err)
+		}
+
+		// Transactions changed while being archived are skipped, so a short
+		// batch means there is nothing left to move for now
+		if moved < archiveBatchSize {
+			break
+		}
+	}
+
+	if archived > 0 {
+		// Archived transactions drop out of the default lists
+		uc.cache.Flush()
+	}
+
+	return archived, nil
+}
@@ -0,0 +1,339 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/proto/inventory"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"cs2-marketplace-microservices/transaction-service/proto/user"
+	"errors"
+	"fmt"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxCartItems is the maximum number of skins bought in one cart purchase
+const maxCartItems = 50
+
+// ProcessCartPurchase buys several listed skins for the buyer at once. Every
+// item is checked first and the buyer's funds for the whole cart are held
+// once, on a parent order. One PENDING child transaction is recorded per item,
+// all skins move to the buyer in one inventory call and the hold is captured;
+// only then are the sellers paid and the children marked COMPLETED. If any
+// step fails, nothing is bought and the order reports why for every item.
+func (uc *transactionUsecase) ProcessCartPurchase(ctx context.Context, req *transaction.ProcessCartPurchaseRequest) (*transaction.CartPurchaseResponse, error) {
+	if req.GetBuyerId() == "" || len(req.GetSkinIds()) == 0 {
+		return nil, errors.New("buyer_id and skin_ids are required")
+	}
+	if len(req.GetSkinIds()) > maxCartItems {
+		return nil, fmt.Errorf("a cart can hold at most %d skins", maxCartItems)
+	}
+
+	buyerID, err := primitive.ObjectIDFromHex(req.GetBuyerId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid buyer_id: %v", err)
+	}
+
+	skinIDs, err := models.ObjectIDsFromHex(req.GetSkinIds())
+	if err != nil {
+		return nil, fmt.Errorf("invalid skin_ids: %v", err)
+	}
+
+	order := &models.Order{
+		BuyerID: buyerID,
+		Status:  models.OrderPending,
+		Total:   models.Money{Currency: uc.currency},
+	}
+	seen := make(map[primitive.ObjectID]bool)
+	for _, skinID := range skinIDs {
+		if seen[skinID] {
+			return nil, fmt.Errorf("skin %s is in the cart more than once", skinID.Hex())
+		}
+		seen[skinID] = true
+		order.Items = append(order.Items, models.OrderItem{SkinID: skinID})
+	}
+
+	conversions := models.ConversionsFromProto(req.GetConversions())
+
+	// Check every item before anything is held, so one bad item fails the
+	// whole cart without touching any balance
+	invalid := 0
+	for i := range order.Items {
+		item := &order.Items[i]
+		if err := uc.checkCartItem(ctx, item, buyerID); err != nil {
+			item.Error = err.Error()
+			invalid++
+			continue
+		}
+
+		price, err := models.Convert(item.Price, order.Total.Currency, conversions)
+		if err != nil {
+			item.Error = err.Error()
+			invalid++
+			continue
+		}
+		order.Total.Units += price.Units
+	}
+	if invalid > 0 {
+		order.Status = models.OrderFailed
+		order.FailureReason = fmt.Sprintf("%d of %d items cannot be bought", invalid, len(order.Items))
+		markItemsNotBought(order)
+	}
+
+	order, err = uc.orderRepo.CreateOrder(ctx, order)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create order: %v", err)
+	}
+	if order.Status == models.OrderFailed {
+		return &transaction.CartPurchaseResponse{Order: order.ToProto()}, nil
+	}
+
+	// Step 1: validate the total against the buyer's balance once by holding it
+	holdResp, err := uc.userClient.PlaceHold(ctx, &user.PlaceHoldRequest{
+		UserId:      buyerID.Hex(),
+		Amount:      order.Total.ToProto(),
+		Conversions: req.GetConversions(),
+		Reference:   order.ID.Hex(),
+	})
+	if err != nil {
+		return uc.failOrder(ctx, order, nil, fmt.Sprintf("failed to hold buyer funds: %v", err))
+	}
+	order.HoldID = holdResp.GetHold().GetId()
+
+	// Step 2: record a PENDING child transaction per item
+	children := make([]*models.Transaction, 0, len(order.Items))
+	for i := range order.Items {
+		item := &order.Items[i]
+		child, err := uc.createCartTransaction(ctx, order, item, conversions)
+		if err != nil {
+			item.Error = err.Error()
+			return uc.failOrder(ctx, order, children, fmt.Sprintf("failed to record item %s", item.SkinID.Hex()))
+		}
+		item.TransactionID = child.ID
+		children = append(children, child)
+	}
+
+	// Step 3: move every skin to the buyer, or none of them
+	if err := uc.moveCartSkins(ctx, children, false); err != nil {
+		return uc.failOrder(ctx, order, children, err.Error())
+	}
+
+	// Step 4: debit the held funds from the buyer
+	_, err = uc.userClient.CaptureHold(ctx, &user.CaptureHoldRequest{
+		HoldId:      order.HoldID,
+		Conversions: req.GetConversions(),
+	})
+	if err != nil {
+		uc.returnCartSkins(context.WithoutCancel(ctx), children)
+		return uc.failOrder(ctx, order, children, fmt.Sprintf("failed to capture buyer funds: %v", err))
+	}
+
+	// The buyer has paid, so from here on failures are logged, not rolled back
+	completed := make([]*transaction.Transaction, 0, len(children))
+	for _, child := range children {
+		uc.payCartSeller(ctx, child)
+
+		t, err := uc.transitionStatus(ctx, child, models.StatusCompleted, nil, models.SystemActor, "order completed")
+		if err != nil {
+			log.Printf("Failed to complete transaction %s of order %s: %v", child.ID.Hex(), order.ID.Hex(), err)
+			t = child
+		}
+		completed = append(completed, t.ToProto())
+
+		uc.invalidateTransactionCaches(child)
+	}
+
+	order.Status = models.OrderCompleted
+	if err := uc.orderRepo.SaveOrder(ctx, order); err != nil {
+		log.Printf("Failed to mark order %s as COMPLETED: %v", order.ID.Hex(), err)
+	}
+
+	return &transaction.CartPurchaseResponse{
+		Order:        order.ToProto(),
+		Transactions: completed,
+	}, nil
+}
+
+// checkCartItem looks up the owner and price of an item's skin and fails if
+// the skin cannot be bought by the buyer or the risk rules block the purchase
+func (uc *transactionUsecase) checkCartItem(ctx context.Context, item *models.OrderItem, buyerID primitive.ObjectID) error {
+	skinResp, err := uc.inventoryClient.GetSkin(ctx, &inventory.GetSkinRequest{Id: item.SkinID.Hex()})
+	if err != nil {
+		return fmt.Errorf("failed to get skin: %v", err)
+	}
+	skin := skinResp.GetSkin()
+
+	item.SkinName = skin.GetName()
+	item.Rarity = skin.GetRarity()
+	item.Price = models.MoneyFromProto(skin.GetPrice())
+
+	if !skin.GetIsListed() {
+		return errors.New("skin is not listed for sale")
+	}
+	if item.Price.Units <= 0 {
+		return errors.New("skin has no valid price")
+	}
+
+	sellerID, err := primitive.ObjectIDFromHex(skin.GetOwnerId())
+	if err != nil || sellerID.IsZero() {
+		return errors.New("skin has no valid owner")
+	}
+	if sellerID == buyerID {
+		return errors.New("buyer already owns this skin")
+	}
+	item.SellerID = sellerID
+
+	candidate := &models.Transaction{BuyerID: buyerID, SellerID: sellerID, SkinID: item.SkinID, Amount: item.Price}
+	item.Risk = uc.assessRisk(ctx, candidate, skin)
+	if item.Risk.Decision == models.RiskBlock {
+		return fmt.Errorf("%w: %s", models.ErrBlockedByRisk, item.Risk.Reasons())
+	}
+
+	return nil
+}
+
+// createCartTransaction records the PENDING child transaction of an order item
+func (uc *transactionUsecase) createCartTransaction(ctx context.Context, order *models.Order, item *models.OrderItem, conversions []models.Conversion) (*models.Transaction, error) {
+	t := &models.Transaction{
+		BuyerID:     order.BuyerID,
+		SellerID:    item.SellerID,
+		SkinID:      item.SkinID,
+		Amount:      item.Price,
+		Conversions: conversions,
+		Status:      models.StatusPending,
+		Type:        models.TypeBuy,
+		Description: fmt.Sprintf("Purchase of %s", item.SkinName),
+		Origin:      models.OriginPurchase,
+		OrderID:     order.ID,
+		Risk:        item.Risk,
+	}
+	if err := uc.applyFees(ctx, t, item.Rarity); err != nil {
+		return nil, err
+	}
+
+	created, err := uc.createPendingTransaction(ctx, t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create purchase transaction: %w", err)
+	}
+	return created, nil
+}
+
+// moveCartSkins moves the skins of an order from their sellers to the buyer in
+// one inventory call, or back again when reverse is set. Skins only move to the
+// buyer while they are still listed at the price of their transaction.
+func (uc *transactionUsecase) moveCartSkins(ctx context.Context, children []*models.Transaction, reverse bool) error {
+	changes := make([]*inventory.OwnershipChange, 0, len(children))
+	for _, t := range children {
+		change := &inventory.OwnershipChange{
+			SkinId:          t.SkinID.Hex(),
+			ExpectedOwnerId: t.SellerID.Hex(),
+			NewOwnerId:      t.BuyerID.Hex(),
+			RequireListed:   true,
+			ExpectedPrice:   t.Amount.ToProto(),
+		}
+		if reverse {
+			change = &inventory.OwnershipChange{
+				SkinId:          t.SkinID.Hex(),
+				ExpectedOwnerId: t.BuyerID.Hex(),
+				NewOwnerId:      t.SellerID.Hex(),
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	_, err := uc.inventoryClient.SwapOwnership(ctx, &inventory.SwapOwnershipRequest{Changes: changes})
+	if status.Code(err) == codes.FailedPrecondition {
+		return fmt.Errorf("ownership transfer failed: %s", status.Convert(err).Message())
+	}
+	if err != nil {
+		return fmt.Errorf("ownership transfer failed: %v", err)
+	}
+	return nil
+}
+
+// returnCartSkins gives the skins of an order back to their sellers and puts
+// them back on the market
+func (uc *transactionUsecase) returnCartSkins(ctx context.Context, children []*models.Transaction) {
+	if err := uc.moveCartSkins(ctx, children, true); err != nil {
+		log.Printf("Compensation failed: could not return the skins of order %s: %v", children[0].OrderID.Hex(), err)
+		return
+	}
+
+	// SwapOwnership unlists the skins, so list them again
+	for _, t := range children {
+		_, err := uc.inventoryClient.ToggleListing(ctx, &inventory.ToggleListingRequest{Id: t.SkinID.Hex(), IsListed: true})
+		if err != nil {
+			log.Printf("Compensation failed: could not re-list skin %s for transaction %s: %v", t.SkinID.Hex(), t.ID.Hex(), err)
+		}
+	}
+}
+
+// payCartSeller credits the seller proceeds of a child transaction, whose
+// funds were captured from the buyer by its order
+func (uc *transactionUsecase) payCartSeller(ctx context.Context, t *models.Transaction) {
+	_, err := uc.userClient.UpdateBalance(ctx, &user.UpdateBalanceRequest{
+		UserId:      t.SellerID.Hex(),
+		Amount:      t.Proceeds().ToProto(),
+		Operation:   "add",
+		Conversions: models.ConversionsToProto(t.Conversions),
+	})
+	if err != nil {
+		log.Printf("Failed to pay %s to seller %s for transaction %s of order %s: %v",
+			t.Proceeds(), t.SellerID.Hex(), t.ID.Hex(), t.OrderID.Hex(), err)
+	}
+}
+
+// failOrder marks the created child transactions and the order as FAILED,
+// releases the order's hold and returns the order with its failure report
+func (uc *transactionUsecase) failOrder(ctx context.Context, order *models.Order, children []*models.Transaction, reason string) (*transaction.CartPurchaseResponse, error) {
+	// Compensating steps must run even if the caller has gone away
+	ctx = context.WithoutCancel(ctx)
+
+	failed := make([]*transaction.Transaction, 0, len(children))
+	for _, child := range children {
+		update := bson.M{
+			"description": fmt.Sprintf("Failed: %s", reason),
+		}
+
+		t, err := uc.transitionStatus(ctx, child, models.StatusFailed, update, models.SystemActor, reason)
+		if err != nil {
+			log.Printf("Failed to mark transaction %s as FAILED: %v", child.ID.Hex(), err)
+			t = child
+		}
+		failed = append(failed, t.ToProto())
+
+		uc.invalidateTransactionCaches(child)
+	}
+
+	if order.HoldID != "" {
+		if _, err := uc.userClient.ReleaseHold(ctx, &user.ReleaseHoldRequest{HoldId: order.HoldID}); err != nil {
+			log.Printf("Failed to release hold %s for order %s: %v", order.HoldID, order.ID.Hex(), err)
+		}
+	}
+
+	order.Status = models.OrderFailed
+	order.FailureReason = reason
+	markItemsNotBought(order)
+	if err := uc.orderRepo.SaveOrder(ctx, order); err != nil {
+		log.Printf("Failed to mark order %s as FAILED: %v", order.ID.Hex(), err)
+	}
+
+	return &transaction.CartPurchaseResponse{
+		Order:        order.ToProto(),
+		Transactions: failed,
+	}, nil
+}
+
+// markItemsNotBought gives every item of a failed order without an error of
+// its own the reason it was not bought
+func markItemsNotBought(order *models.Order) {
+	for i := range order.Items {
+		if order.Items[i].Error == "" {
+			order.Items[i].Error = "not bought: " + order.FailureReason
+		}
+	}
+}
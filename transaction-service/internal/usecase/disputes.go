@@ -0,0 +1,421 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/internal/watch"
+	"cs2-marketplace-microservices/transaction-service/proto/inventory"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"cs2-marketplace-microservices/transaction-service/proto/user"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OpenDispute lets the buyer of a completed transaction dispute it
+func (uc *transactionUsecase) OpenDispute(ctx context.Context, req *transaction.OpenDisputeRequest) (*transaction.DisputeResponse, error) {
+	if req.GetTransactionId() == "" || req.GetBuyerId() == "" || req.GetReason() == "" {
+		return nil, errors.New("transaction_id, buyer_id and reason are required")
+	}
+
+	transactionID, err := primitive.ObjectIDFromHex(req.GetTransactionId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction_id: %v", err)
+	}
+
+	buyerID, err := primitive.ObjectIDFromHex(req.GetBuyerId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid buyer_id: %v", err)
+	}
+
+	t, err := uc.transactionRepo.GetTransactionByID(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	switch {
+	case t.BuyerID != buyerID:
+		return nil, fmt.Errorf("%w: only the buyer can dispute a transaction", models.ErrNotDisputable)
+	case t.Status != models.StatusCompleted:
+		return nil, fmt.Errorf("%w: transaction is %s", models.ErrNotDisputable, t.Status)
+	case t.Type == models.TypeRefund:
+		return nil, fmt.Errorf("%w: refunds cannot be disputed", models.ErrNotDisputable)
+	case t.Type == models.TypeTrade:
+		return nil, fmt.Errorf("%w: trades cannot be disputed", models.ErrNotDisputable)
+	case !t.RefundTransactionID.IsZero():
+		return nil, fmt.Errorf("%w: transaction was already refunded", models.ErrNotDisputable)
+	}
+
+	dispute, err := uc.disputeRepo.CreateDispute(ctx, &models.Dispute{
+		TransactionID: t.ID,
+		BuyerID:       t.BuyerID,
+		SellerID:      t.SellerID,
+		Reason:        req.GetReason(),
+		Evidence:      req.GetEvidence(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open dispute: %w", err)
+	}
+
+	return &transaction.DisputeResponse{
+		Dispute: dispute.ToProto(),
+	}, nil
+}
+
+func (uc *transactionUsecase) GetDispute(ctx context.Context, req *transaction.GetDisputeRequest) (*transaction.DisputeResponse, error) {
+	if req.GetId() == "" {
+		return nil, errors.New("dispute id is required")
+	}
+
+	disputeID, err := primitive.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid dispute id: %v", err)
+	}
+
+	dispute, err := uc.disputeRepo.GetDisputeByID(ctx, disputeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dispute: %w", err)
+	}
+
+	response := &transaction.DisputeResponse{
+		Dispute: dispute.ToProto(),
+	}
+
+	if !dispute.RefundID.IsZero() && dispute.Status == models.DisputeRefunded {
+		refund, err := uc.transactionRepo.GetTransactionByID(ctx, dispute.RefundID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get refund transaction: %w", err)
+		}
+		response.RefundTransaction = refund.ToProto()
+	}
+
+	return response, nil
+}
+
+// ListDisputes returns the admin review queue, oldest dispute first
+func (uc *transactionUsecase) ListDisputes(ctx context.Context, req *transaction.ListDisputesRequest) (*transaction.DisputeListResponse, error) {
+	if _, err := uc.requireAdmin(ctx, req.GetAdminToken()); err != nil {
+		return nil, err
+	}
+
+	var status *models.DisputeStatus
+	if req.Status != nil {
+		s := models.DisputeStatusFromProto(req.GetStatus())
+		status = &s
+	}
+
+	disputes, totalCount, err := uc.disputeRepo.ListDisputes(ctx, status, req.GetLimit(), req.GetOffset())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list disputes: %v", err)
+	}
+
+	protoDisputes := make([]*transaction.Dispute, len(disputes))
+	for i, d := range disputes {
+		protoDisputes[i] = d.ToProto()
+	}
+
+	return &transaction.DisputeListResponse{
+		Disputes:   protoDisputes,
+		TotalCount: int32(totalCount),
+	}, nil
+}
+
+// ResolveDispute closes an open dispute. Resolving in the buyer's favor
+// refunds the transaction through a linked REFUND transaction and can also
+// return the skin to the seller; every step is undone if a later one fails.
+func (uc *transactionUsecase) ResolveDispute(ctx context.Context, req *transaction.ResolveDisputeRequest) (*transaction.DisputeResponse, error) {
+	if req.GetId() == "" {
+		return nil, errors.New("dispute id is required")
+	}
+
+	disputeID, err := primitive.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid dispute id: %v", err)
+	}
+
+	admin, err := uc.requireAdmin(ctx, req.GetAdminToken())
+	if err != nil {
+		return nil, err
+	}
+	adminID, _ := primitive.ObjectIDFromHex(admin)
+
+	dispute, err := uc.disputeRepo.GetDisputeByID(ctx, disputeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dispute: %w", err)
+	}
+	if dispute.Status != models.DisputeOpen {
+		return nil, models.ErrDisputeNotOpen
+	}
+
+	resolution := bson.M{
+		"resolved_by":     adminID,
+		"resolution_note": req.GetNote(),
+		"resolved_at":     time.Now(),
+	}
+
+	if !req.GetRefund() {
+		resolution["status"] = models.DisputeRejected
+		rejected, err := uc.disputeRepo.UpdateDisputeStatus(ctx, disputeID, models.DisputeOpen, resolution)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve dispute: %w", err)
+		}
+		return &transaction.DisputeResponse{
+			Dispute: rejected.ToProto(),
+		}, nil
+	}
+
+	original, err := uc.transactionRepo.GetTransactionByID(ctx, dispute.TransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disputed transaction: %w", err)
+	}
+	if !original.RefundTransactionID.IsZero() {
+		return nil, fmt.Errorf("%w: transaction was already refunded", models.ErrNotDisputable)
+	}
+
+	// Claim the dispute first so it cannot be resolved twice
+	refundID := primitive.NewObjectID()
+	resolution["status"] = models.DisputeRefunded
+	resolution["return_item"] = req.GetReturnItem()
+	resolution["refund_transaction_id"] = refundID
+
+	resolved, err := uc.disputeRepo.UpdateDisputeStatus(ctx, disputeID, models.DisputeOpen, resolution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve dispute: %w", err)
+	}
+
+	// Compensating steps must run even if the caller has gone away
+	compensateCtx := context.WithoutCancel(ctx)
+
+	// The skin is returned first, and only if the buyer still owns it, so a
+	// skin resold in the meantime is never taken from its new owner
+	if req.GetReturnItem() {
+		_, err := uc.inventoryClient.SwapOwnership(ctx, &inventory.SwapOwnershipRequest{
+			Changes: []*inventory.OwnershipChange{{
+				SkinId:          original.SkinID.Hex(),
+				ExpectedOwnerId: original.BuyerID.Hex(),
+				NewOwnerId:      original.SellerID.Hex(),
+			}},
+		})
+		if err != nil {
+			uc.reopenDispute(compensateCtx, disputeID)
+			if status.Code(err) == codes.FailedPrecondition {
+				return nil, models.ErrItemNotReturnable
+			}
+			return nil, fmt.Errorf("failed to return skin to seller: %v", err)
+		}
+	}
+
+	if err := uc.refundFunds(ctx, original); err != nil {
+		if req.GetReturnItem() {
+			uc.returnItemToBuyer(compensateCtx, original)
+		}
+		uc.reopenDispute(compensateCtx, disputeID)
+		return nil, fmt.Errorf("failed to refund buyer: %w", err)
+	}
+
+	refund, err := uc.transactionRepo.CreateTransaction(ctx, &models.Transaction{
+		ID:             refundID,
+		BuyerID:        original.BuyerID,
+		SellerID:       original.SellerID,
+		SkinID:         original.SkinID,
+		Rarity:         original.Rarity,
+		Amount:         original.Amount,
+		FeeAmount:      original.Fee().Neg(),
+		SellerProceeds: original.Proceeds().Neg(),
+		Conversions:    original.Conversions,
+		Status:         models.StatusCompleted,
+		Type:           models.TypeRefund,
+		Description:    fmt.Sprintf("Refund of transaction %s: %s", original.ID.Hex(), dispute.Reason),
+		RefundOfID:     original.ID,
+		DisputeID:      disputeID,
+		History: []models.StatusChange{
+			models.NewStatusChange("", models.StatusCompleted, admin, "dispute resolved in the buyer's favor"),
+		},
+	})
+	if err != nil {
+		if req.GetReturnItem() {
+			uc.returnItemToBuyer(compensateCtx, original)
+		}
+		uc.reverseRefundFunds(compensateCtx, original)
+		uc.reopenDispute(compensateCtx, disputeID)
+		return nil, fmt.Errorf("failed to record refund transaction: %w", err)
+	}
+
+	uc.linkRefund(compensateCtx, original, refundID, admin)
+
+	uc.publish(watch.EventCreated, refund, "")
+	uc.invalidateTransactionCaches(original)
+	uc.invalidateTransactionCaches(refund)
+
+	return &transaction.DisputeResponse{
+		Dispute:           resolved.ToProto(),
+		RefundTransaction: refund.ToProto(),
+	}, nil
+}
+
+// refundFunds returns the amount of t to the buyer: the seller pays back the
+// proceeds and the marketplace returns the fee
+func (uc *transactionUsecase) refundFunds(ctx context.Context, t *models.Transaction) error {
+	if t.SellerID.IsZero() {
+		_, err := uc.userClient.UpdateBalance(ctx, &user.UpdateBalanceRequest{
+			UserId:      t.BuyerID.Hex(),
+			Amount:      t.Amount.ToProto(),
+			Operation:   "add",
+			Conversions: models.ConversionsToProto(t.Conversions),
+		})
+		return err
+	}
+
+	_, err := uc.userClient.TransferBalance(ctx, &user.TransferBalanceRequest{
+		FromUserId:  t.SellerID.Hex(),
+		ToUserId:    t.BuyerID.Hex(),
+		Amount:      t.Proceeds().ToProto(),
+		Conversions: models.ConversionsToProto(t.Conversions),
+	})
+	if err != nil {
+		return err
+	}
+
+	if fee := t.Fee(); fee.Units > 0 {
+		_, err = uc.userClient.UpdateBalance(ctx, &user.UpdateBalanceRequest{
+			UserId:      t.BuyerID.Hex(),
+			Amount:      fee.ToProto(),
+			Operation:   "add",
+			Conversions: models.ConversionsToProto(t.Conversions),
+		})
+		if err != nil {
+			uc.reverseTransfer(context.WithoutCancel(ctx), t, t.BuyerID, t.SellerID, t.Proceeds())
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reverseRefundFunds takes back a refund made by refundFunds
+func (uc *transactionUsecase) reverseRefundFunds(ctx context.Context, t *models.Transaction) {
+	if t.SellerID.IsZero() {
+		_, err := uc.userClient.UpdateBalance(ctx, &user.UpdateBalanceRequest{
+			UserId:      t.BuyerID.Hex(),
+			Amount:      t.Amount.ToProto(),
+			Operation:   "subtract",
+			Conversions: models.ConversionsToProto(t.Conversions),
+		})
+		if err != nil {
+			log.Printf("Compensation failed: could not take back refund %s from buyer %s for transaction %s: %v",
+				t.Amount, t.BuyerID.Hex(), t.ID.Hex(), err)
+		}
+		return
+	}
+
+	uc.reverseTransfer(ctx, t, t.BuyerID, t.SellerID, t.Proceeds())
+
+	if fee := t.Fee(); fee.Units > 0 {
+		_, err := uc.userClient.UpdateBalance(ctx, &user.UpdateBalanceRequest{
+			UserId:      t.BuyerID.Hex(),
+			Amount:      fee.ToProto(),
+			Operation:   "subtract",
+			Conversions: models.ConversionsToProto(t.Conversions),
+		})
+		if err != nil {
+			log.Printf("Compensation failed: could not take back refunded fee %s from buyer %s for transaction %s: %v",
+				fee, t.BuyerID.Hex(), t.ID.Hex(), err)
+		}
+	}
+}
+
+// reverseTransfer moves amount from from to to, using the exchange rates of t
+func (uc *transactionUsecase) reverseTransfer(ctx context.Context, t *models.Transaction, from, to primitive.ObjectID, amount models.Money) {
+	_, err := uc.userClient.TransferBalance(ctx, &user.TransferBalanceRequest{
+		FromUserId:  from.Hex(),
+		ToUserId:    to.Hex(),
+		Amount:      amount.ToProto(),
+		Conversions: models.ConversionsToProto(t.Conversions),
+	})
+	if err != nil {
+		log.Printf("Compensation failed: could not transfer %s from %s back to %s: %v", amount, from.Hex(), to.Hex(), err)
+	}
+}
+
+// returnItemToBuyer gives a returned skin back to the buyer. It does nothing
+// if the seller no longer owns the skin.
+func (uc *transactionUsecase) returnItemToBuyer(ctx context.Context, t *models.Transaction) {
+	_, err := uc.inventoryClient.SwapOwnership(ctx, &inventory.SwapOwnershipRequest{
+		Changes: []*inventory.OwnershipChange{{
+			SkinId:          t.SkinID.Hex(),
+			ExpectedOwnerId: t.SellerID.Hex(),
+			NewOwnerId:      t.BuyerID.Hex(),
+		}},
+	})
+	if err != nil {
+		log.Printf("Compensation failed: could not give skin %s back to buyer %s for transaction %s: %v",
+			t.SkinID.Hex(), t.BuyerID.Hex(), t.ID.Hex(), err)
+	}
+}
+
+// reopenDispute puts a dispute whose refund failed back in the queue
+func (uc *transactionUsecase) reopenDispute(ctx context.Context, disputeID primitive.ObjectID) {
+	_, err := uc.disputeRepo.UpdateDisputeStatus(ctx, disputeID, models.DisputeRefunded,
+		bson.M{"status": models.DisputeOpen, "return_item": false},
+		"resolved_by", "resolution_note", "resolved_at", "refund_transaction_id")
+	if err != nil {
+		log.Printf("Failed to reopen dispute %s after a failed refund: %v", disputeID.Hex(), err)
+	}
+}
+
+// linkRefund records the refund on the original transaction. The refund
+// already references the original, so a failure here is only logged.
+func (uc *transactionUsecase) linkRefund(ctx context.Context, original *models.Transaction, refundID primitive.ObjectID, actorID string) {
+	const attempts = 3
+
+	t := original
+	for i := 0; i < attempts; i++ {
+		change := models.NewStatusChange(t.Status, t.Status, actorID, fmt.Sprintf("refunded by transaction %s", refundID.Hex()))
+		updated, err := uc.transactionRepo.UpdateTransaction(ctx, t.ID, t.Version, bson.M{"refund_transaction_id": refundID}, change)
+		if err == nil {
+			uc.publish(watch.EventUpdated, updated, "")
+			return
+		}
+		if !errors.Is(err, models.ErrVersionConflict) {
+			log.Printf("Failed to link refund %s to transaction %s: %v", refundID.Hex(), t.ID.Hex(), err)
+			return
+		}
+
+		// Reload and retry with the current version
+		t, err = uc.transactionRepo.GetTransactionByID(ctx, original.ID)
+		if err != nil {
+			log.Printf("Failed to link refund %s to transaction %s: %v", refundID.Hex(), original.ID.Hex(), err)
+			return
+		}
+	}
+
+	log.Printf("Failed to link refund %s to transaction %s: too many concurrent updates", refundID.Hex(), original.ID.Hex())
+}
+
+// requireAdmin returns the id of the admin owning the session token. The
+// token is checked by user-service, so callers cannot act as an admin by
+// naming one.
+func (uc *transactionUsecase) requireAdmin(ctx context.Context, adminToken string) (string, error) {
+	if adminToken == "" {
+		return "", models.ErrInvalidSession
+	}
+
+	sessionResp, err := uc.userClient.ValidateSession(ctx, &user.ValidateSessionRequest{SessionToken: adminToken})
+	if status.Code(err) == codes.Unauthenticated {
+		return "", models.ErrInvalidSession
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to validate session: %v", err)
+	}
+	if !sessionResp.GetUser().GetIsAdmin() {
+		return "", models.ErrNotAdmin
+	}
+
+	return sessionResp.GetUser().GetId(), nil
+}
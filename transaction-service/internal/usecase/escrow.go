@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/internal/watch"
+	"cs2-marketplace-microservices/transaction-service/proto/user"
+	"fmt"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// createPendingTransaction holds the buyer's funds for the amount of t and
+// records t as PENDING together with the hold. Trades without a top-up hold
+// nothing, and cart items are paid from the hold of their order.
+func (uc *transactionUsecase) createPendingTransaction(ctx context.Context, t *models.Transaction) (*models.Transaction, error) {
+	// The ID is assigned up front so the hold can reference the transaction
+	t.ID = primitive.NewObjectID()
+	t.Status = models.StatusPending
+
+	if t.Amount.Units > 0 && t.OrderID.IsZero() {
+		holdResp, err := uc.userClient.PlaceHold(ctx, &user.PlaceHoldRequest{
+			UserId:      t.BuyerID.Hex(),
+			Amount:      t.Amount.ToProto(),
+			Conversions: models.ConversionsToProto(t.Conversions),
+			Reference:   t.ID.Hex(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to hold buyer funds: %w", err)
+		}
+		t.HoldID = holdResp.GetHold().GetId()
+	}
+
+	creator := t.BuyerID
+	if !t.ProposerID.IsZero() {
+		creator = t.ProposerID
+	}
+	t.History = []models.StatusChange{
+		models.NewStatusChange("", models.StatusPending, creator.Hex(), "created"),
+	}
+
+	createdTransaction, err := uc.transactionRepo.CreateTransaction(ctx, t)
+	if err != nil {
+		if t.HoldID != "" {
+			uc.releaseHold(context.WithoutCancel(ctx), t)
+		}
+		return nil, err
+	}
+
+	uc.publish(watch.EventCreated, createdTransaction, "")
+
+	return createdTransaction, nil
+}
+
+// captureHold debits the buyer's held funds and credits the seller proceeds to
+// the seller; the fee stays with the marketplace
+func (uc *transactionUsecase) captureHold(ctx context.Context, t *models.Transaction) error {
+	req := &user.CaptureHoldRequest{
+		HoldId:      t.HoldID,
+		Conversions: models.ConversionsToProto(t.Conversions),
+	}
+	if !t.SellerID.IsZero() {
+		req.ToUserId = t.SellerID.Hex()
+		req.CreditAmount = t.Proceeds().ToProto()
+	}
+
+	_, err := uc.userClient.CaptureHold(ctx, req)
+	return err
+}
+
+// releaseHold returns the buyer's held funds to their available balance
+func (uc *transactionUsecase) releaseHold(ctx context.Context, t *models.Transaction) {
+	if _, err := uc.userClient.ReleaseHold(ctx, &user.ReleaseHoldRequest{HoldId: t.HoldID}); err != nil {
+		log.Printf("Failed to release hold %s for transaction %s: %v", t.HoldID, t.ID.Hex(), err)
+	}
+}
+
+// refundBuyer returns the transaction amount to the buyer: the seller proceeds
+// are taken back from the seller and the withheld fee is credited back
+func (uc *transactionUsecase) refundBuyer(ctx context.Context, t *models.Transaction) {
+	if t.SellerID.IsZero() {
+		_, err := uc.userClient.UpdateBalance(ctx, &user.UpdateBalanceRequest{
+			UserId:      t.BuyerID.Hex(),
+			Amount:      t.Amount.ToProto(),
+			Operation:   "add",
+			Conversions: models.ConversionsToProto(t.Conversions),
+		})
+		if err != nil {
+			log.Printf("Compensation failed: could not refund %s to buyer %s for transaction %s: %v",
+				t.Amount, t.BuyerID.Hex(), t.ID.Hex(), err)
+		}
+		return
+	}
+
+	_, err := uc.userClient.TransferBalance(ctx, &user.TransferBalanceRequest{
+		FromUserId:  t.SellerID.Hex(),
+		ToUserId:    t.BuyerID.Hex(),
+		Amount:      t.Proceeds().ToProto(),
+		Conversions: models.ConversionsToProto(t.Conversions),
+	})
+	if err != nil {
+		log.Printf("Compensation failed: could not refund %s to buyer %s for transaction %s: %v",
+			t.Proceeds(), t.BuyerID.Hex(), t.ID.Hex(), err)
+	}
+
+	if fee := t.Fee(); fee.Units > 0 {
+		_, err = uc.userClient.UpdateBalance(ctx, &user.UpdateBalanceRequest{
+			UserId:      t.BuyerID.Hex(),
+			Amount:      fee.ToProto(),
+			Operation:   "add",
+			Conversions: models.ConversionsToProto(t.Conversions),
+		})
+		if err != nil {
+			log.Printf("Compensation failed: could not refund fee %s to buyer %s for transaction %s: %v",
+				fee, t.BuyerID.Hex(), t.ID.Hex(), err)
+		}
+	}
+}
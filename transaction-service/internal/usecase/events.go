@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/internal/watch"
+	"cs2-marketplace-microservices/transaction-service/pkg/metrics"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// NATS subjects of the transaction lifecycle events
+const (
+	SubjectCreated   = "transaction.created"
+	SubjectCompleted = "transaction.completed"
+	SubjectFailed    = "transaction.failed"
+	SubjectCancelled = "transaction.cancelled"
+)
+
+// finalStatusSubjects maps the statuses a transaction ends in to their subjects
+var finalStatusSubjects = map[models.TransactionStatus]string{
+	models.StatusCompleted: SubjectCompleted,
+	models.StatusFailed:    SubjectFailed,
+	models.StatusCancelled: SubjectCancelled,
+}
+
+// publishLifecycle counts t entering a status and publishes the NATS events
+// of the change. Transactions created in a final status, such as refunds and
+// purchases blocked by risk checks, get both the created event and the event
+// of their status.
+func (uc *transactionUsecase) publishLifecycle(eventType watch.EventType, t *models.Transaction, previous models.TransactionStatus, at time.Time) {
+	if eventType == watch.EventCreated || eventType == watch.EventStatusChanged {
+		metrics.RecordTransaction(string(t.Status), string(t.Type), models.MajorUnits(float64(t.Amount.Units), t.Amount.Currency), t.Amount.Currency)
+	}
+
+	if uc.events == nil {
+		return
+	}
+
+	switch eventType {
+	case watch.EventCreated:
+		uc.events.Publish(SubjectCreated, newLifecycleEvent(SubjectCreated, t, nil, at))
+		if subject, ok := finalStatusSubjects[t.Status]; ok {
+			uc.events.Publish(subject, newLifecycleEvent(subject, t, nil, at))
+		}
+	case watch.EventStatusChanged:
+		if subject, ok := finalStatusSubjects[t.Status]; ok {
+			uc.events.Publish(subject, newLifecycleEvent(subject, t, &previous, at))
+		}
+	}
+}
+
+func newLifecycleEvent(subject string, t *models.Transaction, previous *models.TransactionStatus, at time.Time) *transaction.LifecycleEvent {
+	p := t.ToProto()
+	event := &transaction.LifecycleEvent{
+		EventId:        primitive.NewObjectID().Hex(),
+		Subject:        subject,
+		TransactionId:  p.GetId(),
+		Type:           p.GetType(),
+		Status:         p.GetStatus(),
+		BuyerId:        p.GetBuyerId(),
+		SellerId:       p.GetSellerId(),
+		SkinId:         p.GetSkinId(),
+		BuyerSkinIds:   p.GetBuyerSkinIds(),
+		SellerSkinIds:  p.GetSellerSkinIds(),
+		OrderId:        p.GetOrderId(),
+		Amount:         p.GetAmount(),
+		FeeAmount:      p.GetFeeAmount(),
+		SellerProceeds: p.GetSellerProceeds(),
+		CreatedAt:      t.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:      t.UpdatedAt.UTC().Format(time.RFC3339),
+		OccurredAt:     at.UTC().Format(time.RFC3339),
+	}
+	if previous != nil {
+		status := models.StatusToProto(*previous)
+		event.PreviousStatus = &status
+	}
+	return event
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// exportChunkSize is the size at which buffered export data is sent
+const exportChunkSize = 32 * 1024
+
+var exportCSVHeader = []string{
+	"id", "created_at", "type", "status", "role", "counterparty_id", "skin_id",
+	"currency", "amount", "fee_amount", "seller_proceeds", "net_balance_effect", "description",
+}
+
+// exportRow is a transaction as seen by the exporting user
+type exportRow struct {
+	ID               string `json:"id"`
+	CreatedAt        string `json:"created_at"`
+	Type             string `json:"type"`
+	Status           string `json:"status"`
+	Role             string `json:"role"`
+	CounterpartyID   string `json:"counterparty_id"`
+	SkinID           string `json:"skin_id"`
+	Currency         string `json:"currency"`
+	Amount           string `json:"amount"`
+	FeeAmount        string `json:"fee_amount"`
+	SellerProceeds   string `json:"seller_proceeds"`
+	NetBalanceEffect string `json:"net_balance_effect"`
+	Description      string `json:"description"`
+}
+
+func newExportRow(t *models.Transaction, userID primitive.ObjectID) exportRow {
+	row := exportRow{
+		ID:             t.ID.Hex(),
+		CreatedAt:      t.CreatedAt.UTC().Format(time.RFC3339),
+		Type:           string(t.Type),
+		Status:         string(t.Status),
+		SkinID:         t.SkinID.Hex(),
+		Currency:       t.Amount.Currency,
+		Amount:         t.Amount.Decimal(),
+		FeeAmount:      t.Fee().Decimal(),
+		SellerProceeds: t.Proceeds().Decimal(),
+		Description:    t.Description,
+	}
+
+	if t.BuyerID == userID {
+		row.Role = "buyer"
+		if !t.SellerID.IsZero() {
+			row.CounterpartyID = t.SellerID.Hex()
+		}
+	} else {
+		row.Role = "seller"
+		row.CounterpartyID = t.BuyerID.Hex()
+	}
+
+	// Only completed transactions moved money; refunds pay the buyer back
+	net := models.Money{Currency: t.Amount.Currency}
+	if t.Status == models.StatusCompleted {
+		if t.BuyerID == userID {
+			if t.Type == models.TypeRefund {
+				net.Units += t.Amount.Units
+			} else {
+				net.Units -= t.Amount.Units
+			}
+		}
+		if t.SellerID == userID {
+			net.Units += t.Proceeds().Units
+		}
+	}
+	row.NetBalanceEffect = net.Decimal()
+
+	return row
+}
+
+func (r exportRow) csvRecord() []string {
+	return []string{
+		r.ID, r.CreatedAt, r.Type, r.Status, r.Role, r.CounterpartyID, r.SkinID,
+		r.Currency, r.Amount, r.FeeAmount, r.SellerProceeds, r.NetBalanceEffect, r.Description,
+	}
+}
+
+// ExportTransactions streams every transaction of a user in the requested
+// format. Transactions are read from a cursor and sent in chunks, so the
+// export is never held in memory as a whole.
+func (uc *transactionUsecase) ExportTransactions(ctx context.Context, req *transaction.ExportTransactionsRequest, send func(*transaction.ExportChunk) error) error {
+	if req.GetUserId() == "" {
+		return errors.New("user id is required")
+	}
+
+	userID, err := primitive.ObjectIDFromHex(req.GetUserId())
+	if err != nil {
+		return fmt.Errorf("invalid user id: %v", err)
+	}
+
+	from, to, err := parseTimeRange(req.GetStartTime(), req.GetEndTime())
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	flush := func(force bool) error {
+		if buf.Len() == 0 || (!force && buf.Len() < exportChunkSize) {
+			return nil
+		}
+		chunk := &transaction.ExportChunk{Data: bytes.Clone(buf.Bytes())}
+		buf.Reset()
+		return send(chunk)
+	}
+
+	var writeRow func(exportRow) error
+	switch req.GetFormat() {
+	case transaction.ExportFormat_CSV:
+		w := csv.NewWriter(&buf)
+		if err := w.Write(exportCSVHeader); err != nil {
+			return err
+		}
+		w.Flush()
+		writeRow = func(row exportRow) error {
+			if err := w.Write(row.csvRecord()); err != nil {
+				return err
+			}
+			w.Flush()
+			return w.Error()
+		}
+	case transaction.ExportFormat_JSON_LINES:
+		enc := json.NewEncoder(&buf)
+		writeRow = func(row exportRow) error {
+			return enc.Encode(row)
+		}
+	default:
+		return fmt.Errorf("unsupported export format: %v", req.GetFormat())
+	}
+
+	err = uc.transactionRepo.StreamTransactionsByUserID(ctx, userID, from, to, func(t *models.Transaction) error {
+		if err := writeRow(newExportRow(t, userID)); err != nil {
+			return err
+		}
+		return flush(false)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export transactions: %w", err)
+	}
+
+	return flush(true)
+}
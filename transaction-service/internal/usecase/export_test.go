@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestNewExportRow(t *testing.T) {
+	buyer, seller, skin := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+	usd := func(units int64) models.Money { return models.Money{Units: units, Currency: "USD"} }
+
+	sale := func(status models.TransactionStatus) *models.Transaction {
+		return &models.Transaction{
+			ID:             primitive.NewObjectID(),
+			Type:           models.TypeBuy,
+			Status:         status,
+			BuyerID:        buyer,
+			SellerID:       seller,
+			SkinID:         skin,
+			Amount:         usd(10000),
+			FeeAmount:      usd(500),
+			SellerProceeds: usd(9500),
+		}
+	}
+	refund := sale(models.StatusCompleted)
+	refund.Type = models.TypeRefund
+	noFee := sale(models.StatusCompleted)
+	noFee.FeeAmount, noFee.SellerProceeds = models.Money{}, models.Money{}
+	deposit := &models.Transaction{Type: models.TypeBuy, Status: models.StatusCompleted, BuyerID: buyer, Amount: usd(2500)}
+
+	tests := []struct {
+		name             string
+		t                *models.Transaction
+		user             primitive.ObjectID
+		wantRole         string
+		wantCounterparty string
+		wantFee          string
+		wantProceeds     string
+		wantNet          string
+	}{
+		{"buyer of a completed sale", sale(models.StatusCompleted), buyer, "buyer", seller.Hex(), "5.00", "95.00", "-100.00"},
+		{"seller of a completed sale", sale(models.StatusCompleted), seller, "seller", buyer.Hex(), "5.00", "95.00", "95.00"},
+		{"buyer of a pending sale", sale(models.StatusPending), buyer, "buyer", seller.Hex(), "5.00", "95.00", "0.00"},
+		{"seller of a failed sale", sale(models.StatusFailed), seller, "seller", buyer.Hex(), "5.00", "95.00", "0.00"},
+		{"buyer of a refund", refund, buyer, "buyer", seller.Hex(), "5.00", "95.00", "100.00"},
+		{"seller without a recorded fee", noFee, seller, "seller", buyer.Hex(), "0.00", "100.00", "100.00"},
+		{"buyer without a seller", deposit, buyer, "buyer", "", "0.00", "25.00", "-25.00"},
+	}
+
+	for _, tt := range tests {
+		row := newExportRow(tt.t, tt.user)
+
+		if row.Role != tt.wantRole {
+			t.Errorf("%s: Role = %q, want %q", tt.name, row.Role, tt.wantRole)
+		}
+		if row.CounterpartyID != tt.wantCounterparty {
+			t.Errorf("%s: CounterpartyID = %q, want %q", tt.name, row.CounterpartyID, tt.wantCounterparty)
+		}
+		if row.FeeAmount != tt.wantFee {
+			t.Errorf("%s: FeeAmount = %q, want %q", tt.name, row.FeeAmount, tt.wantFee)
+		}
+		if row.SellerProceeds != tt.wantProceeds {
+			t.Errorf("%s: SellerProceeds = %q, want %q", tt.name, row.SellerProceeds, tt.wantProceeds)
+		}
+		if row.NetBalanceEffect != tt.wantNet {
+			t.Errorf("%s: NetBalanceEffect = %q, want %q", tt.name, row.NetBalanceEffect, tt.wantNet)
+		}
+	}
+}
+
+func TestExportCSVRecord(t *testing.T) {
+	buyer := primitive.NewObjectID()
+	tx := &models.Transaction{
+		ID:          primitive.NewObjectID(),
+		Type:        models.TypeBuy,
+		Status:      models.StatusCompleted,
+		BuyerID:     buyer,
+		SkinID:      primitive.NewObjectID(),
+		Amount:      models.Money{Units: 1500, Currency: "JPY"},
+		Description: "AK-47 | Redline",
+		CreatedAt:   time.Date(2024, 3, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600)),
+	}
+
+	record := newExportRow(tx, buyer).csvRecord()
+	if len(record) != len(exportCSVHeader) {
+		t.Fatalf("record has %d fields, header has %d", len(record), len(exportCSVHeader))
+	}
+
+	want := map[string]string{
+		"id":                 tx.ID.Hex(),
+		"created_at":         "2024-03-01T11:00:00Z",
+		"type":               "BUY",
+		"status":             "COMPLETED",
+		"role":               "buyer",
+		"counterparty_id":    "",
+		"skin_id":            tx.SkinID.Hex(),
+		"currency":           "JPY",
+		"amount":             "1500",
+		"fee_amount":         "0",
+		"seller_proceeds":    "1500",
+		"net_balance_effect": "-1500",
+		"description":        "AK-47 | Redline",
+	}
+	for i, column := range exportCSVHeader {
+		if record[i] != want[column] {
+			t.Errorf("%s = %q, want %q", column, record[i], want[column])
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/fees"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/proto/inventory"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"cs2-marketplace-microservices/transaction-service/proto/user"
+	"errors"
+	"fmt"
+	"log"
+)
+
+// QuoteFees returns the fee breakdown for selling a skin at the given amount.
+// Without an amount the current price of the skin is quoted.
+func (uc *transactionUsecase) QuoteFees(ctx context.Context, req *transaction.QuoteFeesRequest) (*transaction.QuoteFeesResponse, error) {
+	amount := models.MoneyFromProto(req.GetAmount())
+	rarity := req.GetRarity()
+	sellerID := req.GetSellerId()
+
+	if req.GetSkinId() != "" && (amount.Units <= 0 || rarity == "" || sellerID == "") {
+		skinResp, err := uc.inventoryClient.GetSkin(ctx, &inventory.GetSkinRequest{Id: req.GetSkinId()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get skin: %v", err)
+		}
+		skin := skinResp.GetSkin()
+
+		if amount.Units <= 0 {
+			amount = models.MoneyFromProto(skin.GetPrice())
+		}
+		if rarity == "" {
+			rarity = skin.GetRarity()
+		}
+		if sellerID == "" {
+			sellerID = skin.GetOwnerId()
+		}
+	}
+
+	if amount.Units <= 0 {
+		return nil, errors.New("amount or skin_id with a valid price is required")
+	}
+	if !models.ValidCurrency(amount.Currency) {
+		return nil, fmt.Errorf("unsupported currency %q", amount.Currency)
+	}
+
+	quote, err := uc.feeEngine.Quote(fees.QuoteInput{
+		Amount:        amount,
+		Conversions:   models.ConversionsFromProto(req.GetConversions()),
+		Rarity:        rarity,
+		SellerID:      sellerID,
+		SellerIsAdmin: uc.isAdmin(ctx, sellerID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction.QuoteFeesResponse{
+		Amount:         quote.Amount.ToProto(),
+		FeePercent:     quote.Percent,
+		FixedFee:       quote.FixedFee.ToProto(),
+		FeeAmount:      quote.FeeAmount.ToProto(),
+		SellerProceeds: quote.SellerProceeds.ToProto(),
+		Exempt:         quote.Exempt,
+	}, nil
+}
+
+// applyFees sets the fee and seller proceeds of t, and the rarity of its skin
+// the fee depends on. A transaction without a seller pays nobody, so no fee is
+// withheld.
+func (uc *transactionUsecase) applyFees(ctx context.Context, t *models.Transaction, rarity string) error {
+	t.Rarity = rarity
+	if t.SellerID.IsZero() {
+		t.FeeAmount = models.Money{Currency: t.Amount.Currency}
+		t.SellerProceeds = t.Amount
+		return nil
+	}
+
+	quote, err := uc.feeEngine.Quote(fees.QuoteInput{
+		Amount:        t.Amount,
+		Conversions:   t.Conversions,
+		Rarity:        rarity,
+		SellerID:      t.SellerID.Hex(),
+		SellerIsAdmin: uc.isAdmin(ctx, t.SellerID.Hex()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to compute fees: %w", err)
+	}
+
+	t.FeeAmount = quote.FeeAmount
+	t.SellerProceeds = quote.SellerProceeds
+	return nil
+}
+
+// skinRarity looks up the rarity of a skin, which fee schedules and grouped
+// statistics depend on. Fees fall back to the default percentage if the skin
+// cannot be loaded.
+func (uc *transactionUsecase) skinRarity(ctx context.Context, skinID string) string {
+	skinResp, err := uc.inventoryClient.GetSkin(ctx, &inventory.GetSkinRequest{Id: skinID})
+	if err != nil {
+		log.Printf("Failed to get rarity of skin %s for fee calculation: %v", skinID, err)
+		return ""
+	}
+
+	return skinResp.GetSkin().GetRarity()
+}
+
+// isAdmin reports whether userID belongs to an admin when the fee schedule
+// exempts admins
+func (uc *transactionUsecase) isAdmin(ctx context.Context, userID string) bool {
+	if userID == "" || !uc.feeEngine.ChecksAdmins() {
+		return false
+	}
+
+	userResp, err := uc.userClient.GetUser(ctx, &user.GetUserRequest{UserId: userID})
+	if err != nil {
+		log.Printf("Failed to get user %s for fee calculation: %v", userID, err)
+		return false
+	}
+
+	return userResp.GetUser().GetIsAdmin()
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// GetTransactionHistory returns every recorded change of a transaction, oldest first.
+// It always reads the database so support never sees a stale history. With
+// include_archived, archived transactions are found too.
+func (uc *transactionUsecase) GetTransactionHistory(ctx context.Context, req *transaction.GetTransactionRequest) (*transaction.TransactionHistoryResponse, error) {
+	if req.GetId() == "" {
+		return nil, errors.New("transaction id is required")
+	}
+
+	objID, err := primitive.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction id: %v", err)
+	}
+
+	trans, err := uc.transactionRepo.GetTransactionByID(ctx, objID)
+	if errors.Is(err, models.ErrTransactionNotFound) && req.GetIncludeArchived() {
+		trans, err = uc.transactionRepo.GetArchivedTransactionByID(ctx, objID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	history := make([]*transaction.StatusChange, len(trans.History))
+	for i := range trans.History {
+		history[i] = trans.History[i].ToProto()
+	}
+
+	return &transaction.TransactionHistoryResponse{
+		TransactionId: trans.ID.Hex(),
+		History:       history,
+	}, nil
+}
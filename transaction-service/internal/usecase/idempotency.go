@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// requestFingerprint identifies the parameters of a request, so a retry can be
+// told apart from a different request that reuses the same idempotency key
+func requestFingerprint(parts ...interface{}) string {
+	h := sha256.New()
+	for _, part := range parts {
+		fmt.Fprintf(h, "%v|", part)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// findIdempotentTransaction returns the transaction the buyer created earlier
+// with key, or nil if no key was given or the key is unused or expired
+func (uc *transactionUsecase) findIdempotentTransaction(ctx context.Context, buyerID primitive.ObjectID, key, fingerprint string) (*models.Transaction, error) {
+	if key == "" {
+		return nil, nil
+	}
+
+	existing, err := uc.transactionRepo.GetTransactionByIdempotencyKey(ctx, buyerID, key)
+	if errors.Is(err, models.ErrTransactionNotFound) {
+		// An expired key may still be attached to an old transaction
+		if err := uc.transactionRepo.ReleaseExpiredIdempotencyKey(ctx, buyerID, key); err != nil {
+			return nil, fmt.Errorf("failed to release idempotency key: %v", err)
+		}
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up idempotency key: %v", err)
+	}
+
+	if existing.RequestHash != fingerprint {
+		return nil, models.ErrIdempotencyKeyReused
+	}
+
+	return existing, nil
+}
+
+// setIdempotencyKey attaches key to a transaction that is about to be created
+func (uc *transactionUsecase) setIdempotencyKey(t *models.Transaction, key, fingerprint string) {
+	if key == "" {
+		return
+	}
+
+	t.IdempotencyKey = key
+	t.RequestHash = fingerprint
+	t.IdempotencyExpiresAt = time.Now().Add(uc.idempotencyKeyRetention)
+}
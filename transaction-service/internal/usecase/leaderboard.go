@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/internal/repository/mongo"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"cs2-marketplace-microservices/transaction-service/proto/user"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Leaderboard sizes
+const (
+	defaultLeaderboardLimit = 10
+	maxLeaderboardLimit     = 100
+)
+
+// errLeaderboardFull stops ranking once a leaderboard has all its entries
+var errLeaderboardFull = errors.New("leaderboard full")
+
+// GetLeaderboard returns the top traders by a metric over a window. Leaderboards
+// are served from the cache, which RefreshLeaderboards keeps current, and are
+// only computed here when missing.
+func (uc *transactionUsecase) GetLeaderboard(ctx context.Context, req *transaction.GetLeaderboardRequest) (*transaction.LeaderboardResponse, error) {
+	metric, ok := models.LeaderboardMetricFromProto(req.GetMetric())
+	if !ok {
+		return nil, fmt.Errorf("unsupported metric: %v", req.GetMetric())
+	}
+	window, ok := models.LeaderboardWindowFromProto(req.GetWindow())
+	if !ok {
+		return nil, fmt.Errorf("unsupported window: %v", req.GetWindow())
+	}
+
+	currency, err := uc.reportCurrency(req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultLeaderboardLimit
+	}
+	limit = min(limit, maxLeaderboardLimit)
+
+	board, err := uc.cachedLeaderboard(ctx, metric, window, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// The cached leaderboard is shared, so only its entries are sliced
+	response := &transaction.LeaderboardResponse{
+		Metric:      req.GetMetric(),
+		Window:      req.GetWindow(),
+		Currency:    board.GetCurrency(),
+		Entries:     board.GetEntries(),
+		GeneratedAt: board.GetGeneratedAt(),
+	}
+	if len(response.Entries) > limit {
+		response.Entries = response.Entries[:limit]
+	}
+
+	return response, nil
+}
+
+// RefreshLeaderboards recomputes every leaderboard in the marketplace currency
+func (uc *transactionUsecase) RefreshLeaderboards(ctx context.Context) error {
+	for _, metric := range models.LeaderboardMetrics {
+		for _, window := range models.LeaderboardWindows {
+			board, err := uc.buildLeaderboard(ctx, metric, window, uc.currency)
+			if err != nil {
+				return fmt.Errorf("failed to refresh %s leaderboard by %s: %w", window, metric, err)
+			}
+			uc.cache.Set(leaderboardCacheKey(metric, window, uc.currency), board, leaderboardCacheTTL)
+		}
+	}
+	return nil
+}
+
+func leaderboardCacheKey(metric models.LeaderboardMetric, window models.LeaderboardWindow, currency string) string {
+	return fmt.Sprintf("%s%s_%s_%s", leaderboardCachePrefix, metric, window, currency)
+}
+
+// cachedLeaderboard returns the cached leaderboard, computing it on a miss
+func (uc *transactionUsecase) cachedLeaderboard(ctx context.Context, metric models.LeaderboardMetric, window models.LeaderboardWindow, currency string) (*transaction.LeaderboardResponse, error) {
+	cacheKey := leaderboardCacheKey(metric, window, currency)
+	if cached, found := uc.cache.Get(cacheKey); found {
+		if board, ok := cached.(*transaction.LeaderboardResponse); ok {
+			return board, nil
+		}
+	}
+
+	board, err := uc.buildLeaderboard(ctx, metric, window, currency)
+	if err != nil {
+		return nil, err
+	}
+	uc.cache.Set(cacheKey, board, leaderboardCacheTTL)
+
+	return board, nil
+}
+
+// buildLeaderboard ranks traders and fills in the first maxLeaderboardLimit
+// who have not opted out of leaderboards
+func (uc *transactionUsecase) buildLeaderboard(ctx context.Context, metric models.LeaderboardMetric, window models.LeaderboardWindow, currency string) (*transaction.LeaderboardResponse, error) {
+	now := time.Now()
+	board := &transaction.LeaderboardResponse{
+		Currency:    currency,
+		GeneratedAt: now.UTC().Format(time.RFC3339),
+	}
+
+	filter := mongo.RankTradersFilter{
+		Metric:   metric,
+		Currency: currency,
+		Since:    window.Since(now),
+	}
+	err := uc.transactionRepo.RankTraders(ctx, filter, func(stats *models.TraderStats) error {
+		userResp, err := uc.userClient.GetUser(ctx, &user.GetUserRequest{UserId: stats.UserID.Hex()})
+		if status.Code(err) == codes.NotFound {
+			// Deleted users are left out
+			return nil
+		}
+		if err != nil {
+			// Without the profile the opt-out cannot be honored
+			return fmt.Errorf("failed to get user %s: %w", stats.UserID.Hex(), err)
+		}
+		if userResp.GetUser().GetLeaderboardOptOut() {
+			return nil
+		}
+
+		board.Entries = append(board.Entries, &transaction.LeaderboardEntry{
+			Rank:         int32(len(board.Entries) + 1),
+			UserId:       stats.UserID.Hex(),
+			Username:     userResp.GetUser().GetUsername(),
+			Volume:       models.Money{Units: stats.Volume, Currency: currency}.ToProto(),
+			Transactions: stats.Transactions,
+			Profit:       models.Money{Units: stats.Profit, Currency: currency}.ToProto(),
+		})
+		if len(board.Entries) == maxLeaderboardLimit {
+			return errLeaderboardFull
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errLeaderboardFull) {
+		return nil, fmt.Errorf("failed to rank traders: %w", err)
+	}
+
+	return board, nil
+}
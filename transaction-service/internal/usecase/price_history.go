@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/proto/inventory"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"errors"
+	"fmt"
+	"log"
+)
+
+// GetSkinPriceHistory returns price candles of the completed sales of every
+// skin with a name and condition, read from the pre-aggregated candles
+func (uc *transactionUsecase) GetSkinPriceHistory(ctx context.Context, req *transaction.GetSkinPriceHistoryRequest) (*transaction.SkinPriceHistoryResponse, error) {
+	if req.GetSkinName() == "" || req.GetCondition() == "" {
+		return nil, errors.New("skin_name and condition are required")
+	}
+
+	interval, ok := models.CandleIntervalFromProto(req.GetInterval())
+	if !ok {
+		return nil, fmt.Errorf("unsupported interval: %v", req.GetInterval())
+	}
+
+	currency, err := uc.reportCurrency(req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err := parseTimeRange(req.GetStartTime(), req.GetEndTime())
+	if err != nil {
+		return nil, err
+	}
+
+	candles, err := uc.priceRepo.GetCandles(ctx, req.GetSkinName(), req.GetCondition(), currency, interval, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price history: %v", err)
+	}
+
+	response := &transaction.SkinPriceHistoryResponse{
+		SkinName:  req.GetSkinName(),
+		Condition: req.GetCondition(),
+		Interval:  req.GetInterval(),
+		Currency:  currency,
+		Candles:   make([]*transaction.PriceCandle, 0, len(candles)),
+	}
+	for i := range candles {
+		response.Candles = append(response.Candles, candles[i].ToProto())
+	}
+
+	return response, nil
+}
+
+// recordSale adds a completed sale to the price history of its skin. Refunds
+// and trades are not sales of one skin. A failure leaves a gap in the history,
+// and a later refund leaves the sale in it, until the candles are rebuilt with
+// cmd/candles.
+func (uc *transactionUsecase) recordSale(ctx context.Context, t *models.Transaction) {
+	if t.Type != models.TypeBuy && t.Type != models.TypeSell {
+		return
+	}
+
+	skinResp, err := uc.inventoryClient.GetSkin(ctx, &inventory.GetSkinRequest{Id: t.SkinID.Hex()})
+	if err != nil {
+		log.Printf("Failed to get skin %s to record the price of transaction %s: %v", t.SkinID.Hex(), t.ID.Hex(), err)
+		return
+	}
+	skin := skinResp.GetSkin()
+
+	err = uc.priceRepo.RecordSale(ctx, models.Sale{
+		SkinName:  skin.GetName(),
+		Condition: skin.GetCondition(),
+		Price:     t.Amount,
+		At:        t.CompletedAt(),
+	})
+	if err != nil {
+		log.Printf("Failed to record the price of transaction %s: %v", t.ID.Hex(), err)
+	}
+}
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/proto/inventory"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"errors"
+	"fmt"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProcessPurchase buys a listed skin for the buyer. The purchase runs as a saga:
+// the transaction is recorded as PENDING with the buyer's funds on hold, the
+// skin moves from seller to buyer and the transaction is marked COMPLETED,
+// which captures the held funds for the seller. When a step fails, the steps
+// before it are compensated and the transaction is marked FAILED.
+func (uc *transactionUsecase) ProcessPurchase(ctx context.Context, req *transaction.ProcessPurchaseRequest) (*transaction.TransactionResponse, error) {
+	if req.GetBuyerId() == "" || req.GetSkinId() == "" {
+		return nil, errors.New("buyer_id and skin_id are required")
+	}
+
+	buyerID, err := primitive.ObjectIDFromHex(req.GetBuyerId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid buyer_id: %v", err)
+	}
+
+	skinID, err := primitive.ObjectIDFromHex(req.GetSkinId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid skin_id: %v", err)
+	}
+
+	// Return the original transaction if this is a retry
+	conversions := models.ConversionsFromProto(req.GetConversions())
+	fingerprint := requestFingerprint("purchase", req.GetBuyerId(), req.GetSkinId(), conversions)
+	existing, err := uc.findIdempotentTransaction(ctx, buyerID, req.GetIdempotencyKey(), fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return &transaction.TransactionResponse{
+			Transaction: existing.ToProto(),
+		}, nil
+	}
+
+	// Look up the real owner and price of the skin
+	skinResp, err := uc.inventoryClient.GetSkin(ctx, &inventory.GetSkinRequest{Id: req.GetSkinId()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skin: %v", err)
+	}
+	skin := skinResp.GetSkin()
+
+	if !skin.GetIsListed() {
+		return nil, errors.New("skin is not listed for sale")
+	}
+	price := models.MoneyFromProto(skin.GetPrice())
+	if price.Units <= 0 {
+		return nil, errors.New("skin has no valid price")
+	}
+
+	sellerID, err := primitive.ObjectIDFromHex(skin.GetOwnerId())
+	if err != nil || sellerID.IsZero() {
+		return nil, errors.New("skin has no valid owner")
+	}
+	if sellerID == buyerID {
+		return nil, errors.New("buyer already owns this skin")
+	}
+
+	newTransaction := &models.Transaction{
+		BuyerID:     buyerID,
+		SellerID:    sellerID,
+		SkinID:      skinID,
+		Amount:      price,
+		Conversions: conversions,
+		Status:      models.StatusPending,
+		Type:        models.TypeBuy,
+		Description: fmt.Sprintf("Purchase of %s", skin.GetName()),
+		Origin:      models.OriginPurchase,
+	}
+	if err := uc.applyFees(ctx, newTransaction, skin.GetRarity()); err != nil {
+		return nil, err
+	}
+	if uc.assessRisk(ctx, newTransaction, skin).Decision == models.RiskBlock {
+		return nil, uc.recordBlockedTransaction(ctx, newTransaction)
+	}
+	uc.setIdempotencyKey(newTransaction, req.GetIdempotencyKey(), fingerprint)
+
+	createdTransaction, err := uc.createPendingTransaction(ctx, newTransaction)
+	if errors.Is(err, models.ErrDuplicateIdempotencyKey) {
+		// A concurrent retry started the purchase first
+		existing, err = uc.findIdempotentTransaction(ctx, buyerID, req.GetIdempotencyKey(), fingerprint)
+		if err == nil && existing != nil {
+			return &transaction.TransactionResponse{
+				Transaction: existing.ToProto(),
+			}, nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create purchase transaction: %w", err)
+	}
+
+	// Invalidate related caches
+	uc.invalidateTransactionCaches(createdTransaction)
+
+	completedTransaction, err := uc.runPurchaseSaga(ctx, createdTransaction, skin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction.TransactionResponse{
+		Transaction: completedTransaction.ToProto(),
+	}, nil
+}
+
+// runPurchaseSaga moves the skin for a PENDING purchase and completes it,
+// compensating already applied steps on failure
+func (uc *transactionUsecase) runPurchaseSaga(ctx context.Context, t *models.Transaction, skin *inventory.Skin) (*models.Transaction, error) {
+	// Compensating steps must run even if the caller has gone away
+	compensateCtx := context.WithoutCancel(ctx)
+
+	// Step 1: move the skin to the buyer, only if the seller still owns it and
+	// it is still listed at the price on hold, so concurrent purchases of the
+	// same skin cannot both succeed and a repriced skin is not sold at its old
+	// price
+	_, err := uc.inventoryClient.SwapOwnership(ctx, &inventory.SwapOwnershipRequest{
+		Changes: []*inventory.OwnershipChange{{
+			SkinId:          skin.GetId(),
+			ExpectedOwnerId: t.SellerID.Hex(),
+			NewOwnerId:      t.BuyerID.Hex(),
+			RequireListed:   true,
+			ExpectedPrice:   t.Amount.ToProto(),
+		}},
+	})
+	if status.Code(err) == codes.FailedPrecondition {
+		return nil, uc.failPurchase(compensateCtx, t, fmt.Sprintf("skin is no longer for sale: %s", status.Convert(err).Message()))
+	}
+	if err != nil {
+		return nil, uc.failPurchase(compensateCtx, t, fmt.Sprintf("ownership transfer failed: %v", err))
+	}
+
+	// Step 2: mark the transaction as completed, which pays the seller
+	completedTransaction, err := uc.transitionStatus(ctx, t, models.StatusCompleted, nil, models.SystemActor, "purchase completed")
+	if err != nil {
+		uc.revertOwnership(compensateCtx, t, skin)
+		return nil, uc.failPurchase(compensateCtx, t, fmt.Sprintf("failed to complete transaction: %v", err))
+	}
+
+	uc.invalidateTransactionCaches(t)
+
+	return completedTransaction, nil
+}
+
+// revertOwnership gives the skin back to the seller and puts it back on the
+// market. It does nothing if the buyer no longer owns the skin.
+func (uc *transactionUsecase) revertOwnership(ctx context.Context, t *models.Transaction, skin *inventory.Skin) {
+	_, err := uc.inventoryClient.SwapOwnership(ctx, &inventory.SwapOwnershipRequest{
+		Changes: []*inventory.OwnershipChange{{
+			SkinId:          skin.GetId(),
+			ExpectedOwnerId: t.BuyerID.Hex(),
+			NewOwnerId:      t.SellerID.Hex(),
+		}},
+	})
+	if err != nil {
+		log.Printf("Compensation failed: could not return skin %s to seller %s for transaction %s: %v",
+			skin.GetId(), t.SellerID.Hex(), t.ID.Hex(), err)
+		return
+	}
+
+	// SwapOwnership unlists the skin, so list it again
+	_, err = uc.inventoryClient.ToggleListing(ctx, &inventory.ToggleListingRequest{Id: skin.GetId(), IsListed: true})
+	if err != nil {
+		log.Printf("Compensation failed: could not re-list skin %s for transaction %s: %v", skin.GetId(), t.ID.Hex(), err)
+	}
+}
+
+// failPurchase marks the transaction as FAILED, which releases the buyer's held
+// funds, and returns the error reported to the caller
+func (uc *transactionUsecase) failPurchase(ctx context.Context, t *models.Transaction, reason string) error {
+	update := bson.M{
+		"description": fmt.Sprintf("Failed: %s", reason),
+	}
+
+	if _, err := uc.transitionStatus(ctx, t, models.StatusFailed, update, models.SystemActor, reason); err != nil {
+		log.Printf("Failed to mark transaction %s as FAILED: %v", t.ID.Hex(), err)
+	}
+
+	uc.invalidateTransactionCaches(t)
+
+	return fmt.Errorf("purchase failed: %s", reason)
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/proto/inventory"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// reaperBatchSize is the number of stale transactions loaded at a time
+const reaperBatchSize = 100
+
+// ReapStalePendingTransactions fails the transactions that have been PENDING
+// for longer than timeout, or tradeTimeout for trade offers waiting to be
+// accepted, and returns how many it failed. Each transaction is failed with a
+// versioned write, so replicas reaping at the same time never process a
+// transaction twice.
+func (uc *transactionUsecase) ReapStalePendingTransactions(ctx context.Context, timeout, tradeTimeout time.Duration) (int, error) {
+	now := time.Now()
+	cutoff := now.Add(-timeout)
+	tradeCutoff := now.Add(-tradeTimeout)
+	reaped := 0
+
+	for {
+		stale, err := uc.transactionRepo.GetStalePendingTransactions(ctx, cutoff, tradeCutoff, reaperBatchSize)
+		if err != nil {
+			return reaped, fmt.Errorf("failed to get stale transactions: %v", err)
+		}
+
+		batchReaped := 0
+		for i := range stale {
+			staleAfter := timeout
+			if stale[i].Type == models.TypeTrade {
+				staleAfter = tradeTimeout
+			}
+			if uc.reapTransaction(ctx, &stale[i], staleAfter) {
+				batchReaped++
+			}
+		}
+		reaped += batchReaped
+
+		// Stop when done, or when nothing in the batch could be failed so the
+		// same transactions would be loaded again
+		if len(stale) < reaperBatchSize || batchReaped == 0 {
+			return reaped, nil
+		}
+	}
+}
+
+// reapTransaction fails a stale transaction and puts its skin back on the
+// market if a purchase had already moved it to the buyer
+func (uc *transactionUsecase) reapTransaction(ctx context.Context, t *models.Transaction, timeout time.Duration) bool {
+	reason := fmt.Sprintf("timed out after %s in PENDING", timeout)
+	update := bson.M{
+		"description": fmt.Sprintf("Failed: %s", reason),
+	}
+
+	_, err := uc.transitionStatus(ctx, t, models.StatusFailed, update, models.SystemActor, reason)
+	if errors.Is(err, models.ErrVersionConflict) || errors.Is(err, models.ErrTransactionNotFound) {
+		// Another replica or the purchase itself got there first
+		return false
+	}
+	if err != nil {
+		log.Printf("Failed to reap stale transaction %s: %v", t.ID.Hex(), err)
+		return false
+	}
+
+	if t.Origin == models.OriginPurchase {
+		uc.restoreSkin(ctx, t)
+	}
+
+	uc.invalidateTransactionCaches(t)
+
+	return true
+}
+
+// restoreSkin returns the skin of a failed purchase to the seller and re-lists
+// it if the ownership transfer had already happened
+func (uc *transactionUsecase) restoreSkin(ctx context.Context, t *models.Transaction) {
+	skinResp, err := uc.inventoryClient.GetSkin(ctx, &inventory.GetSkinRequest{Id: t.SkinID.Hex()})
+	if err != nil {
+		log.Printf("Failed to check skin %s of reaped transaction %s: %v", t.SkinID.Hex(), t.ID.Hex(), err)
+		return
+	}
+
+	skin := skinResp.GetSkin()
+	if skin.GetOwnerId() == t.BuyerID.Hex() {
+		uc.revertOwnership(ctx, t, skin)
+	}
+}
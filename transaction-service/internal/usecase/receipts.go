@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/internal/receipt"
+	"cs2-marketplace-microservices/transaction-service/proto/inventory"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"cs2-marketplace-microservices/transaction-service/proto/user"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// GetReceipt returns the receipt of a completed purchase, rendered as HTML and
+// plain text. A receipt that could not be issued when the purchase completed
+// is issued now.
+func (uc *transactionUsecase) GetReceipt(ctx context.Context, req *transaction.GetReceiptRequest) (*transaction.ReceiptResponse, error) {
+	if req.GetTransactionId() == "" {
+		return nil, errors.New("transaction id is required")
+	}
+
+	objID, err := primitive.ObjectIDFromHex(req.GetTransactionId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction id: %v", err)
+	}
+
+	r, err := uc.receiptRepo.GetReceiptByTransactionID(ctx, objID)
+	if errors.Is(err, models.ErrReceiptNotFound) {
+		t, getErr := uc.transactionRepo.GetTransactionByID(ctx, objID)
+		if errors.Is(getErr, models.ErrTransactionNotFound) {
+			t, getErr = uc.transactionRepo.GetArchivedTransactionByID(ctx, objID)
+		}
+		if getErr != nil {
+			return nil, fmt.Errorf("failed to get transaction: %w", getErr)
+		}
+		r, err = uc.issueReceipt(ctx, t)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receipt: %w", err)
+	}
+
+	html, err := receipt.HTML(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render receipt: %v", err)
+	}
+	text, err := receipt.Text(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render receipt: %v", err)
+	}
+
+	return &transaction.ReceiptResponse{
+		Receipt: r.ToProto(html, text),
+	}, nil
+}
+
+// issueReceipt numbers and stores the receipt of a completed purchase, or
+// returns the receipt it already has
+func (uc *transactionUsecase) issueReceipt(ctx context.Context, t *models.Transaction) (*models.Receipt, error) {
+	if t.Status != models.StatusCompleted || (t.Type != models.TypeBuy && t.Type != models.TypeSell) {
+		return nil, models.ErrNoReceipt
+	}
+
+	skinResp, err := uc.inventoryClient.GetSkin(ctx, &inventory.GetSkinRequest{Id: t.SkinID.Hex()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get skin %s: %v", t.SkinID.Hex(), err)
+	}
+	skin := skinResp.GetSkin()
+
+	buyer, err := uc.userClient.GetUser(ctx, &user.GetUserRequest{UserId: t.BuyerID.Hex()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get buyer %s: %v", t.BuyerID.Hex(), err)
+	}
+
+	sellerUsername := ""
+	if !t.SellerID.IsZero() {
+		seller, err := uc.userClient.GetUser(ctx, &user.GetUserRequest{UserId: t.SellerID.Hex()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get seller %s: %v", t.SellerID.Hex(), err)
+		}
+		sellerUsername = seller.GetUser().GetUsername()
+	}
+
+	r := &models.Receipt{
+		TransactionID:  t.ID,
+		BuyerID:        t.BuyerID,
+		BuyerUsername:  buyer.GetUser().GetUsername(),
+		SellerID:       t.SellerID,
+		SellerUsername: sellerUsername,
+		Items: []models.ReceiptItem{{
+			SkinID:    t.SkinID,
+			Name:      skin.GetName(),
+			Condition: skin.GetCondition(),
+			Rarity:    skin.GetRarity(),
+			Price:     t.Amount,
+		}},
+		Subtotal:       t.Amount,
+		FeeAmount:      t.Fee(),
+		SellerProceeds: t.Proceeds(),
+		Total:          t.Amount,
+	}
+
+	created, err := uc.receiptRepo.CreateReceipt(ctx, r)
+	if errors.Is(err, models.ErrDuplicateReceipt) {
+		// Issued concurrently, e.g. by a fetch right after the purchase
+		return uc.receiptRepo.GetReceiptByTransactionID(ctx, t.ID)
+	}
+	return created, err
+}
+
+// sendReceipt issues the receipt of a completed purchase and emails it to the
+// buyer. Failures are only logged: the receipt is issued on its first fetch
+// if it could not be issued here.
+func (uc *transactionUsecase) sendReceipt(ctx context.Context, t *models.Transaction) {
+	if t.Type != models.TypeBuy && t.Type != models.TypeSell {
+		return
+	}
+
+	r, err := uc.issueReceipt(ctx, t)
+	if err != nil {
+		log.Printf("Failed to issue receipt for transaction %s: %v", t.ID.Hex(), err)
+		return
+	}
+
+	buyer, err := uc.userClient.GetUser(ctx, &user.GetUserRequest{UserId: t.BuyerID.Hex()})
+	if err != nil {
+		log.Printf("Failed to get buyer %s to email receipt %s: %v", t.BuyerID.Hex(), r.FormattedNumber(), err)
+		return
+	}
+	to := buyer.GetUser().GetEmail()
+	if to == "" {
+		return
+	}
+
+	text, err := receipt.Text(r)
+	if err != nil {
+		log.Printf("Failed to render receipt %s: %v", r.FormattedNumber(), err)
+		return
+	}
+	html, err := receipt.HTML(r)
+	if err != nil {
+		log.Printf("Failed to render receipt %s: %v", r.FormattedNumber(), err)
+		return
+	}
+
+	subject := fmt.Sprintf("Your CS2 Skins Marketplace receipt %s", r.FormattedNumber())
+	if err := uc.emailSender.SendMultipartEmail(to, subject, text, html); err != nil {
+		log.Printf("Failed to email receipt %s to buyer %s: %v", r.FormattedNumber(), t.BuyerID.Hex(), err)
+		return
+	}
+
+	if err := uc.receiptRepo.MarkReceiptEmailed(ctx, r.ID, time.Now()); err != nil {
+		log.Printf("Failed to mark receipt %s as emailed: %v", r.FormattedNumber(), err)
+	}
+}
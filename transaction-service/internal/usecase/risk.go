@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/internal/risk"
+	"cs2-marketplace-microservices/transaction-service/internal/watch"
+	"cs2-marketplace-microservices/transaction-service/proto/inventory"
+	"cs2-marketplace-microservices/transaction-service/proto/user"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// assessRisk runs the risk rules for a new transaction and stores the
+// assessment on it. skin may be nil if the caller has not looked it up.
+func (uc *transactionUsecase) assessRisk(ctx context.Context, t *models.Transaction, skin *inventory.Skin) *models.RiskAssessment {
+	in := risk.Input{
+		BuyerID:  t.BuyerID,
+		SellerID: t.SellerID,
+		SkinID:   t.SkinID,
+		Amount:   t.Amount,
+	}
+	if skin != nil {
+		in.SkinName = skin.GetName()
+		in.Condition = skin.GetCondition()
+	}
+
+	assessment := uc.riskChain.Evaluate(ctx, in, riskData{uc: uc})
+	if assessment.Decision == models.RiskReview {
+		log.Printf("Transaction of buyer %s for skin %s flagged for review: %s",
+			t.BuyerID.Hex(), t.SkinID.Hex(), assessment.Reasons())
+	}
+
+	t.Risk = &assessment
+	return t.Risk
+}
+
+// recordBlockedTransaction records a transaction blocked by the risk rules as
+// FAILED, without holding any funds, and returns the error reported to the caller
+func (uc *transactionUsecase) recordBlockedTransaction(ctx context.Context, t *models.Transaction) error {
+	reason := fmt.Sprintf("blocked by risk checks: %s", t.Risk.Reasons())
+
+	t.Status = models.StatusFailed
+	t.Description = fmt.Sprintf("Failed: %s", reason)
+	t.History = []models.StatusChange{
+		models.NewStatusChange("", models.StatusFailed, t.BuyerID.Hex(), reason),
+	}
+
+	blocked, err := uc.transactionRepo.CreateTransaction(ctx, t)
+	if err != nil {
+		return fmt.Errorf("failed to record blocked transaction: %w", err)
+	}
+
+	uc.publish(watch.EventCreated, blocked, "")
+	uc.invalidateTransactionCaches(blocked)
+
+	return fmt.Errorf("%w: %s (transaction %s)", models.ErrBlockedByRisk, t.Risk.Reasons(), blocked.ID.Hex())
+}
+
+// riskData looks up the history the risk rules check
+type riskData struct {
+	uc *transactionUsecase
+}
+
+func (d riskData) CountBuyerTransactions(ctx context.Context, buyerID primitive.ObjectID, since time.Time) (int64, error) {
+	return d.uc.transactionRepo.CountBuyerTransactionsSince(ctx, buyerID, since)
+}
+
+func (d riskData) CountPairTransactions(ctx context.Context, skinID, userA, userB primitive.ObjectID, since time.Time) (int64, error) {
+	return d.uc.transactionRepo.CountPairTransactionsSince(ctx, skinID, userA, userB, since)
+}
+
+// AveragePrice averages the daily price candles of the skin's name and condition
+func (d riskData) AveragePrice(ctx context.Context, in risk.Input, currency string, since time.Time) (models.Money, bool, error) {
+	name, condition := in.SkinName, in.Condition
+	if name == "" || condition == "" {
+		skinResp, err := d.uc.inventoryClient.GetSkin(ctx, &inventory.GetSkinRequest{Id: in.SkinID.Hex()})
+		if err != nil {
+			return models.Money{}, false, fmt.Errorf("failed to get skin: %v", err)
+		}
+		name, condition = skinResp.GetSkin().GetName(), skinResp.GetSkin().GetCondition()
+	}
+
+	candles, err := d.uc.priceRepo.GetCandles(ctx, name, condition, currency, models.CandleDay, &since, nil)
+	if err != nil {
+		return models.Money{}, false, err
+	}
+
+	var volume, sales int64
+	for _, c := range candles {
+		volume += c.Volume
+		sales += c.Sales
+	}
+	if sales == 0 {
+		return models.Money{}, false, nil
+	}
+
+	return models.Money{Units: volume / sales, Currency: currency}, true, nil
+}
+
+func (d riskData) AccountCreatedAt(ctx context.Context, userID primitive.ObjectID) (time.Time, error) {
+	userResp, err := d.uc.userClient.GetUser(ctx, &user.GetUserRequest{UserId: userID.Hex()})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get user: %v", err)
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, userResp.GetUser().GetCreatedAt())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid created_at of user %s: %v", userID.Hex(), err)
+	}
+	return createdAt, nil
+}
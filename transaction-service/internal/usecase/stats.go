@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/internal/repository/mongo"
+	"cs2-marketplace-microservices/transaction-service/proto/inventory"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// unknownRarity is the rarity of skins that no longer exist in inventory
+const unknownRarity = "unknown"
+
+// rarityLookups is the number of skins looked up at once for transactions
+// created before their rarity was stored
+const rarityLookups = 8
+
+// parseStatsDimensions returns the requested dimensions without duplicates
+func parseStatsDimensions(groupBy []transaction.StatsDimension, userID *primitive.ObjectID) ([]transaction.StatsDimension, error) {
+	var dims []transaction.StatsDimension
+	for _, dim := range groupBy {
+		if _, ok := transaction.StatsDimension_name[int32(dim)]; !ok {
+			return nil, fmt.Errorf("unsupported group_by dimension: %v", dim)
+		}
+		if dim == transaction.StatsDimension_STATS_BY_ROLE && userID == nil {
+			return nil, errors.New("grouping by role requires a user_id")
+		}
+		if !slices.Contains(dims, dim) {
+			dims = append(dims, dim)
+		}
+	}
+	return dims, nil
+}
+
+// statsGroups computes the statistics of the transactions counted by the
+// stats for each combination of the values of dims, largest group first
+func (uc *transactionUsecase) statsGroups(ctx context.Context, userID *primitive.ObjectID, startDate, endDate, currency string, dims []transaction.StatsDimension) ([]*transaction.StatsGroup, error) {
+	if len(dims) == 0 {
+		return nil, nil
+	}
+
+	var grouping mongo.StatsGrouping
+	for _, dim := range dims {
+		switch dim {
+		case transaction.StatsDimension_STATS_BY_TYPE:
+			grouping.Type = true
+		case transaction.StatsDimension_STATS_BY_STATUS:
+			grouping.Status = true
+		case transaction.StatsDimension_STATS_BY_ROLE:
+			grouping.Role = userID
+		case transaction.StatsDimension_STATS_BY_RARITY:
+			grouping.Rarity = true
+		}
+	}
+
+	if grouping.Rarity {
+		skinIDs, err := uc.transactionRepo.GetSkinsWithoutRarity(ctx, userID, startDate, endDate, currency)
+		if err != nil {
+			return nil, err
+		}
+		grouping.Rarities, err = uc.skinRarities(ctx, skinIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	groups, err := uc.transactionRepo.GetGroupedTransactionStats(ctx, userID, startDate, endDate, currency, grouping)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*transaction.StatsGroup, 0, len(groups))
+	for i := range groups {
+		group := &groups[i]
+		rows = append(rows, &transaction.StatsGroup{
+			Type:                     group.Key.Type,
+			Status:                   group.Key.Status,
+			Role:                     group.Key.Role,
+			Rarity:                   group.Key.Rarity,
+			TotalTransactions:        group.TotalTransactions,
+			TotalAmount:              models.MajorUnits(group.TotalAmount, currency),
+			AverageTransactionAmount: models.MajorUnits(group.AverageAmount, currency),
+			MedianAmount:             models.MajorUnits(group.MedianAmount(), currency),
+			TotalFeeRevenue:          models.MajorUnits(group.TotalFeeRevenue, currency),
+		})
+	}
+
+	// Largest groups first, ties in a stable order
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if a.TotalTransactions != b.TotalTransactions {
+			return a.TotalTransactions > b.TotalTransactions
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Status != b.Status {
+			return a.Status < b.Status
+		}
+		if a.Role != b.Role {
+			return a.Role < b.Role
+		}
+		return a.Rarity < b.Rarity
+	})
+
+	return rows, nil
+}
+
+// skinRarities looks up the rarity of skins, a few at a time. These are the
+// skins of transactions created before the rarity was stored on them, so the
+// number of lookups shrinks as they age out of the requested range.
+func (uc *transactionUsecase) skinRarities(ctx context.Context, skinIDs []primitive.ObjectID) (map[primitive.ObjectID]string, error) {
+	var mu sync.Mutex
+	rarities := make(map[primitive.ObjectID]string, len(skinIDs))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(rarityLookups)
+	for _, skinID := range skinIDs {
+		g.Go(func() error {
+			rarity := unknownRarity
+			skinResp, err := uc.inventoryClient.GetSkin(gctx, &inventory.GetSkinRequest{Id: skinID.Hex()})
+			switch {
+			case status.Code(err) == codes.NotFound:
+			case err != nil:
+				return fmt.Errorf("failed to get skin %s: %v", skinID.Hex(), err)
+			default:
+				rarity = skinResp.GetSkin().GetRarity()
+			}
+
+			mu.Lock()
+			rarities[skinID] = rarity
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return rarities, nil
+}
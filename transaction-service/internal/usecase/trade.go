@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/proto/inventory"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"errors"
+	"fmt"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateTrade offers a swap of skins between two users. The trade is recorded
+// as PENDING with the buyer's top-up on hold until the other side accepts it.
+func (uc *transactionUsecase) CreateTrade(ctx context.Context, req *transaction.CreateTradeRequest) (*transaction.TransactionResponse, error) {
+	if req.GetProposerId() == "" || req.GetBuyerId() == "" || req.GetSellerId() == "" {
+		return nil, errors.New("proposer_id, buyer_id and seller_id are required")
+	}
+	if req.GetBuyerId() == req.GetSellerId() {
+		return nil, errors.New("a user cannot trade with themselves")
+	}
+	if req.GetProposerId() != req.GetBuyerId() && req.GetProposerId() != req.GetSellerId() {
+		return nil, errors.New("proposer_id must be the buyer or the seller")
+	}
+	if len(req.GetBuyerSkinIds())+len(req.GetSellerSkinIds()) == 0 {
+		return nil, errors.New("a trade needs at least one skin")
+	}
+
+	buyerID, err := primitive.ObjectIDFromHex(req.GetBuyerId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid buyer_id: %v", err)
+	}
+
+	sellerID, err := primitive.ObjectIDFromHex(req.GetSellerId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid seller_id: %v", err)
+	}
+
+	buyerSkinIDs, err := models.ObjectIDsFromHex(req.GetBuyerSkinIds())
+	if err != nil {
+		return nil, fmt.Errorf("invalid buyer_skin_ids: %v", err)
+	}
+
+	sellerSkinIDs, err := models.ObjectIDsFromHex(req.GetSellerSkinIds())
+	if err != nil {
+		return nil, fmt.Errorf("invalid seller_skin_ids: %v", err)
+	}
+
+	seen := make(map[primitive.ObjectID]bool)
+	for _, id := range append(append([]primitive.ObjectID(nil), buyerSkinIDs...), sellerSkinIDs...) {
+		if seen[id] {
+			return nil, fmt.Errorf("skin %s is listed more than once", id.Hex())
+		}
+		seen[id] = true
+	}
+
+	topUp := models.MoneyFromProto(req.GetTopUp())
+	if topUp.Units < 0 {
+		return nil, errors.New("top_up cannot be negative")
+	}
+	if topUp.Currency == "" {
+		topUp.Currency = uc.currency
+	}
+	if !models.ValidCurrency(topUp.Currency) {
+		return nil, fmt.Errorf("unsupported currency %q", topUp.Currency)
+	}
+
+	// Return the original trade if this is a retry
+	conversions := models.ConversionsFromProto(req.GetConversions())
+	fingerprint := requestFingerprint("trade", req.GetProposerId(), req.GetBuyerId(), req.GetSellerId(),
+		req.GetBuyerSkinIds(), req.GetSellerSkinIds(), topUp, req.GetDescription(), conversions)
+	existing, err := uc.findIdempotentTransaction(ctx, buyerID, req.GetIdempotencyKey(), fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return &transaction.TransactionResponse{
+			Transaction: existing.ToProto(),
+		}, nil
+	}
+
+	// Ownership is checked again, atomically, when the trade completes
+	if err := uc.checkSkinOwners(ctx, buyerSkinIDs, buyerID); err != nil {
+		return nil, err
+	}
+	if err := uc.checkSkinOwners(ctx, sellerSkinIDs, sellerID); err != nil {
+		return nil, err
+	}
+
+	description := req.GetDescription()
+	if description == "" {
+		description = fmt.Sprintf("Trade of %d for %d skins", len(buyerSkinIDs), len(sellerSkinIDs))
+	}
+
+	newTransaction := &models.Transaction{
+		BuyerID:       buyerID,
+		SellerID:      sellerID,
+		Amount:        topUp,
+		Conversions:   conversions,
+		Status:        models.StatusPending,
+		Type:          models.TypeTrade,
+		Description:   description,
+		BuyerSkinIDs:  buyerSkinIDs,
+		SellerSkinIDs: sellerSkinIDs,
+	}
+	newTransaction.ProposerID, _ = primitive.ObjectIDFromHex(req.GetProposerId())
+
+	// Fees are only taken from the top-up
+	if err := uc.applyFees(ctx, newTransaction, ""); err != nil {
+		return nil, err
+	}
+	uc.setIdempotencyKey(newTransaction, req.GetIdempotencyKey(), fingerprint)
+
+	createdTransaction, err := uc.createPendingTransaction(ctx, newTransaction)
+	if errors.Is(err, models.ErrDuplicateIdempotencyKey) {
+		// A concurrent retry created the trade first
+		existing, err = uc.findIdempotentTransaction(ctx, buyerID, req.GetIdempotencyKey(), fingerprint)
+		if err == nil && existing != nil {
+			return &transaction.TransactionResponse{
+				Transaction: existing.ToProto(),
+			}, nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trade: %w", err)
+	}
+
+	uc.invalidateTransactionCaches(createdTransaction)
+
+	return &transaction.TransactionResponse{
+		Transaction: createdTransaction.ToProto(),
+	}, nil
+}
+
+// AcceptTrade completes a pending trade on behalf of the side that did not
+// propose it. The skins are swapped in one step and the top-up is paid to the
+// seller; if any skin changed hands since the offer, the whole trade fails.
+func (uc *transactionUsecase) AcceptTrade(ctx context.Context, req *transaction.AcceptTradeRequest) (*transaction.TransactionResponse, error) {
+	if req.GetTransactionId() == "" || req.GetUserId() == "" {
+		return nil, errors.New("transaction_id and user_id are required")
+	}
+
+	objID, err := primitive.ObjectIDFromHex(req.GetTransactionId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction id: %v", err)
+	}
+
+	userID, err := primitive.ObjectIDFromHex(req.GetUserId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid user_id: %v", err)
+	}
+
+	t, err := uc.transactionRepo.GetTransactionByID(ctx, objID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if t.Type != models.TypeTrade {
+		return nil, models.ErrNotTrade
+	}
+	if userID == t.ProposerID || (userID != t.BuyerID && userID != t.SellerID) {
+		return nil, models.ErrNotTradeParty
+	}
+
+	completed, err := uc.transitionStatus(ctx, t, models.StatusCompleted, nil, req.GetUserId(), "trade accepted")
+	if errors.Is(err, models.ErrTradeItemNotOwned) {
+		// The offer can never succeed, so the top-up is released
+		update := bson.M{"description": fmt.Sprintf("Failed: %v", err)}
+		if _, failErr := uc.transitionStatus(context.WithoutCancel(ctx), t, models.StatusFailed, update, models.SystemActor, err.Error()); failErr != nil {
+			log.Printf("Failed to mark trade %s as FAILED: %v", t.ID.Hex(), failErr)
+		}
+	}
+	uc.invalidateTransactionCaches(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete trade: %w", err)
+	}
+
+	return &transaction.TransactionResponse{
+		Transaction: completed.ToProto(),
+	}, nil
+}
+
+// checkSkinOwners fails with ErrTradeItemNotOwned unless owner owns every skin
+func (uc *transactionUsecase) checkSkinOwners(ctx context.Context, skinIDs []primitive.ObjectID, owner primitive.ObjectID) error {
+	for _, skinID := range skinIDs {
+		skinResp, err := uc.inventoryClient.GetSkin(ctx, &inventory.GetSkinRequest{Id: skinID.Hex()})
+		if err != nil {
+			return fmt.Errorf("failed to get skin %s: %v", skinID.Hex(), err)
+		}
+		if skinResp.GetSkin().GetOwnerId() != owner.Hex() {
+			return fmt.Errorf("%w: skin %s", models.ErrTradeItemNotOwned, skinID.Hex())
+		}
+	}
+	return nil
+}
+
+// swapTradeSkins moves the buyer's skins to the seller and the seller's skins
+// to the buyer in one inventory call, or back again when reverse is set
+func (uc *transactionUsecase) swapTradeSkins(ctx context.Context, t *models.Transaction, reverse bool) error {
+	changes := make([]*inventory.OwnershipChange, 0, len(t.BuyerSkinIDs)+len(t.SellerSkinIDs))
+	add := func(skinIDs []primitive.ObjectID, from, to primitive.ObjectID) {
+		if reverse {
+			from, to = to, from
+		}
+		for _, skinID := range skinIDs {
+			changes = append(changes, &inventory.OwnershipChange{
+				SkinId:          skinID.Hex(),
+				ExpectedOwnerId: from.Hex(),
+				NewOwnerId:      to.Hex(),
+			})
+		}
+	}
+	add(t.BuyerSkinIDs, t.BuyerID, t.SellerID)
+	add(t.SellerSkinIDs, t.SellerID, t.BuyerID)
+
+	_, err := uc.inventoryClient.SwapOwnership(ctx, &inventory.SwapOwnershipRequest{Changes: changes})
+	if status.Code(err) == codes.FailedPrecondition {
+		return fmt.Errorf("%w: %s", models.ErrTradeItemNotOwned, status.Convert(err).Message())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to swap trade skins: %v", err)
+	}
+	return nil
+}
+
+// reverseTradeSkins undoes the swap of a trade that could not be completed
+func (uc *transactionUsecase) reverseTradeSkins(ctx context.Context, t *models.Transaction) {
+	if err := uc.swapTradeSkins(ctx, t, true); err != nil {
+		log.Printf("Compensation failed: could not swap back the skins of trade %s: %v", t.ID.Hex(), err)
+	}
+}
@@ -2,14 +2,21 @@ package usecase
 
 import (
 	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/cache"
+	"cs2-marketplace-microservices/transaction-service/internal/fees"
 	"cs2-marketplace-microservices/transaction-service/internal/models"
 	"cs2-marketplace-microservices/transaction-service/internal/repository"
+	"cs2-marketplace-microservices/transaction-service/internal/risk"
+	"cs2-marketplace-microservices/transaction-service/internal/watch"
+	"cs2-marketplace-microservices/transaction-service/pkg/email"
+	"cs2-marketplace-microservices/transaction-service/pkg/messaging"
+	"cs2-marketplace-microservices/transaction-service/proto/inventory"
 	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"cs2-marketplace-microservices/transaction-service/proto/user"
 	"errors"
 	"fmt"
 	"time"
 
-	"github.com/patrickmn/go-cache"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -17,21 +24,55 @@ import (
 type TransactionUsecase interface {
 	CreateTransaction(ctx context.Context, req *transaction.CreateTransactionRequest) (*transaction.TransactionResponse, error)
 	GetTransaction(ctx context.Context, req *transaction.GetTransactionRequest) (*transaction.TransactionResponse, error)
+	GetTransactionHistory(ctx context.Context, req *transaction.GetTransactionRequest) (*transaction.TransactionHistoryResponse, error)
+	GetReceipt(ctx context.Context, req *transaction.GetReceiptRequest) (*transaction.ReceiptResponse, error)
 	UpdateTransaction(ctx context.Context, req *transaction.UpdateTransactionRequest) (*transaction.TransactionResponse, error)
 	DeleteTransaction(ctx context.Context, req *transaction.GetTransactionRequest) (*transaction.DeleteResponse, error)
+	RestoreTransaction(ctx context.Context, req *transaction.RestoreTransactionRequest) (*transaction.TransactionResponse, error)
 	ListTransactions(ctx context.Context, req *transaction.GetTransactionsByUserRequest) (*transaction.TransactionListResponse, error)
 	GetTransactionsByUser(ctx context.Context, req *transaction.GetTransactionsByUserRequest) (*transaction.TransactionListResponse, error)
 	GetTransactionsBySkin(ctx context.Context, req *transaction.GetTransactionsBySkinRequest) (*transaction.TransactionListResponse, error)
 	GetTransactionsByStatus(ctx context.Context, req *transaction.GetTransactionsByStatusRequest) (*transaction.TransactionListResponse, error)
 	ProcessPurchase(ctx context.Context, req *transaction.ProcessPurchaseRequest) (*transaction.TransactionResponse, error)
+	ProcessCartPurchase(ctx context.Context, req *transaction.ProcessCartPurchaseRequest) (*transaction.CartPurchaseResponse, error)
 	CancelTransaction(ctx context.Context, req *transaction.CancelTransactionRequest) (*transaction.TransactionResponse, error)
+	CreateTrade(ctx context.Context, req *transaction.CreateTradeRequest) (*transaction.TransactionResponse, error)
+	AcceptTrade(ctx context.Context, req *transaction.AcceptTradeRequest) (*transaction.TransactionResponse, error)
 	GetTransactionStats(ctx context.Context, req *transaction.GetTransactionStatsRequest) (*transaction.TransactionStatsResponse, error)
 	GetAllTransactions(ctx context.Context, req *transaction.GetTransactionsByStatusRequest) (*transaction.TransactionListResponse, error)
+	QuoteFees(ctx context.Context, req *transaction.QuoteFeesRequest) (*transaction.QuoteFeesResponse, error)
+	GetTransactionVolumeSeries(ctx context.Context, req *transaction.GetTransactionVolumeSeriesRequest) (*transaction.TransactionVolumeSeriesResponse, error)
+	GetSkinPriceHistory(ctx context.Context, req *transaction.GetSkinPriceHistoryRequest) (*transaction.SkinPriceHistoryResponse, error)
+	GetLeaderboard(ctx context.Context, req *transaction.GetLeaderboardRequest) (*transaction.LeaderboardResponse, error)
+	WatchTransactions(ctx context.Context, req *transaction.WatchTransactionsRequest, send func(*transaction.TransactionEvent) error) error
+	ExportTransactions(ctx context.Context, req *transaction.ExportTransactionsRequest, send func(*transaction.ExportChunk) error) error
+	OpenDispute(ctx context.Context, req *transaction.OpenDisputeRequest) (*transaction.DisputeResponse, error)
+	GetDispute(ctx context.Context, req *transaction.GetDisputeRequest) (*transaction.DisputeResponse, error)
+	ListDisputes(ctx context.Context, req *transaction.ListDisputesRequest) (*transaction.DisputeListResponse, error)
+	ResolveDispute(ctx context.Context, req *transaction.ResolveDisputeRequest) (*transaction.DisputeResponse, error)
+	ReapStalePendingTransactions(ctx context.Context, timeout, tradeTimeout time.Duration) (int, error)
+	FeedWatchers(ctx context.Context) error
+	ArchiveTransactions(ctx context.Context, age time.Duration) (int, error)
+	RefreshLeaderboards(ctx context.Context) error
 }
 
 type transactionUsecase struct {
 	transactionRepo repository.TransactionRepository
+	disputeRepo     repository.DisputeRepository
+	priceRepo       repository.PriceHistoryRepository
+	orderRepo       repository.OrderRepository
+	receiptRepo     repository.ReceiptRepository
+	inventoryClient inventory.InventoryServiceClient
+	userClient      user.UserServiceClient
+	feeEngine       *fees.Engine
+	riskChain       *risk.Chain
+	emailSender     email.Sender
+	events          *messaging.Publisher
+	currency        string
 	cache           *cache.Cache
+	watchers        *watch.Hub
+
+	idempotencyKeyRetention time.Duration
 }
 
 // Cache key constants
@@ -42,6 +83,7 @@ const (
 	statusTransactionsCachePrefix = "status_transactions:"
 	statsCachePrefix              = "stats:"
 	allTransactionsCachePrefix    = "all_transactions:"
+	leaderboardCachePrefix        = "leaderboard:"
 )
 
 // Cache TTL settings
@@ -49,15 +91,27 @@ const (
 	transactionCacheTTL = 5 * time.Minute  // Individual transactions
 	listCacheTTL        = 2 * time.Minute  // Lists (shorter TTL as they change more often)
 	statsCacheTTL       = 10 * time.Minute // Stats (longer TTL as they're expensive to compute)
+	leaderboardCacheTTL = 30 * time.Minute // Leaderboards (kept current by the refresher, not by invalidation)
 )
 
-func NewTransactionUsecase(transactionRepo repository.TransactionRepository) TransactionUsecase {
-	// Create cache with default expiration of 5 minutes and cleanup every 10 minutes
-	c := cache.New(5*time.Minute, 10*time.Minute)
-
+func NewTransactionUsecase(transactionRepo repository.TransactionRepository, disputeRepo repository.DisputeRepository, priceRepo repository.PriceHistoryRepository, orderRepo repository.OrderRepository, receiptRepo repository.ReceiptRepository, inventoryClient inventory.InventoryServiceClient, userClient user.UserServiceClient, feeEngine *fees.Engine, riskChain *risk.Chain, emailSender email.Sender, eventPublisher *messaging.Publisher, c *cache.Cache, currency string, idempotencyKeyRetention time.Duration) TransactionUsecase {
 	return &transactionUsecase{
 		transactionRepo: transactionRepo,
+		disputeRepo:     disputeRepo,
+		priceRepo:       priceRepo,
+		orderRepo:       orderRepo,
+		receiptRepo:     receiptRepo,
+		inventoryClient: inventoryClient,
+		userClient:      userClient,
+		feeEngine:       feeEngine,
+		riskChain:       riskChain,
+		emailSender:     emailSender,
+		events:          eventPublisher,
+		currency:        currency,
 		cache:           c,
+		watchers:        watch.NewHub(watchHistorySize),
+
+		idempotencyKeyRetention: idempotencyKeyRetention,
 	}
 }
 
@@ -75,45 +129,125 @@ func (uc *transactionUsecase) getListCacheKey(prefix string, params ...interface
 	return key
 }
 
-// Helper function to invalidate related caches when transaction is modified
-func (uc *transactionUsecase) invalidateTransactionCaches(transactionID string, userID, skinID *primitive.ObjectID) {
-	// Invalidate specific transaction cache
-	uc.cache.Delete(uc.getCacheKey(transactionCachePrefix, transactionID))
+// newPage builds the page selected by a list request
+func newPage(limit, offset int32, pageToken string, includeArchived bool) (models.Page, error) {
+	after, err := models.ParsePageToken(pageToken)
+	if err != nil {
+		return models.Page{}, err
+	}
+	return models.Page{Limit: limit, Offset: offset, After: after, IncludeArchived: includeArchived}, nil
+}
 
-	// Invalidate user-related caches if userID is provided
-	if userID != nil {
-		uc.cache.DeleteExpired() // Clean up to find user-related keys
-		// In a real implementation, you might want to track user-related keys separately
-		// For simplicity, we'll use a pattern-based approach or clear related patterns
+// nextPageToken returns the token of the page after transactions, or an empty
+// token if transactions is the last page
+func nextPageToken(transactions []models.Transaction, limit int32) string {
+	if limit <= 0 || len(transactions) < int(limit) {
+		return ""
 	}
+	return models.CursorOf(&transactions[len(transactions)-1]).Token()
+}
 
-	// Invalidate skin-related caches
-	if skinID != nil {
-		skinKey := uc.getCacheKey(skinTransactionsCachePrefix, skinID.Hex())
-		uc.cache.Delete(skinKey)
+// invalidateTransactionCaches removes the cached transaction and every
+// cached list and stat it may appear in or count towards
+func (uc *transactionUsecase) invalidateTransactionCaches(t *models.Transaction) {
+	uc.cache.Delete(uc.getCacheKey(transactionCachePrefix, t.ID.Hex()))
+
+	tags := []string{cache.AllTag, cache.UserTag(t.BuyerID.Hex())}
+	if !t.SellerID.IsZero() {
+		tags = append(tags, cache.UserTag(t.SellerID.Hex()))
+	}
+	if !t.SkinID.IsZero() {
+		tags = append(tags, cache.SkinTag(t.SkinID.Hex()))
+	}
+	for _, skinID := range append(append([]primitive.ObjectID(nil), t.BuyerSkinIDs...), t.SellerSkinIDs...) {
+		tags = append(tags, cache.SkinTag(skinID.Hex()))
 	}
 
-	// Clear all transactions cache as it might be affected
-	uc.cache.Delete(allTransactionsCachePrefix)
+	// Transactions only move from PENDING to a terminal status, and a caller
+	// holding a PENDING copy may not know which one it moved to
+	tags = append(tags, cache.StatusTag(string(models.StatusPending)))
+	if t.Status == models.StatusPending {
+		for _, status := range models.TerminalStatuses {
+			tags = append(tags, cache.StatusTag(string(status)))
+		}
+	} else {
+		tags = append(tags, cache.StatusTag(string(t.Status)))
+	}
 
-	// Clear stats cache as transaction changes affect statistics
-	uc.clearStatsCaches()
+	uc.cache.Invalidate(tags...)
 }
 
-func (uc *transactionUsecase) clearStatsCaches() {
-	// Clear all stats-related cache entries
-	items := uc.cache.Items()
-	for key := range items {
-		if len(key) >= len(statsCachePrefix) && key[:len(statsCachePrefix)] == statsCachePrefix {
-			uc.cache.Delete(key)
+// transitionStatus moves t to the next status, applying update in the same write
+// and recording the change by actorID in the history.
+// The write only succeeds if t has not been modified since it was read.
+// The buyer's held funds are captured on completion and released on cancel/fail.
+func (uc *transactionUsecase) transitionStatus(ctx context.Context, t *models.Transaction, next models.TransactionStatus, update bson.M, actorID, reason string) (*models.Transaction, error) {
+	if !t.Status.CanTransitionTo(next) {
+		return nil, fmt.Errorf("%w: %s -> %s", models.ErrInvalidStatusTransition, t.Status, next)
+	}
+
+	if update == nil {
+		update = bson.M{}
+	}
+	update["status"] = next
+
+	// Trades swap their skins first, so a trade whose skins have changed hands
+	// fails before anyone is charged
+	swapped := false
+	if next == models.StatusCompleted && t.Type == models.TypeTrade {
+		if err := uc.swapTradeSkins(ctx, t, false); err != nil {
+			return nil, err
+		}
+		swapped = true
+	}
+
+	// Funds are captured before the write so a COMPLETED transaction is always paid for
+	if next == models.StatusCompleted && t.HoldID != "" {
+		if err := uc.captureHold(ctx, t); err != nil {
+			if swapped {
+				uc.reverseTradeSkins(context.WithoutCancel(ctx), t)
+			}
+			return nil, fmt.Errorf("failed to capture buyer funds: %w", err)
 		}
 	}
+
+	change := models.NewStatusChange(t.Status, next, actorID, reason)
+	updatedTransaction, err := uc.transactionRepo.UpdateTransaction(ctx, t.ID, t.Version, update, change)
+	if err != nil {
+		if next == models.StatusCompleted && t.HoldID != "" {
+			uc.refundBuyer(context.WithoutCancel(ctx), t)
+		}
+		if swapped {
+			uc.reverseTradeSkins(context.WithoutCancel(ctx), t)
+		}
+		return nil, err
+	}
+
+	// Funds are released only after the write so a concurrent completion cannot lose its hold
+	if (next == models.StatusFailed || next == models.StatusCancelled) && t.HoldID != "" {
+		uc.releaseHold(ctx, t)
+	}
+
+	if next == models.StatusCompleted {
+		uc.recordSale(context.WithoutCancel(ctx), updatedTransaction)
+
+		// Receipts are issued and emailed in the background
+		go uc.sendReceipt(context.WithoutCancel(ctx), updatedTransaction)
+	}
+
+	uc.publish(watch.EventStatusChanged, updatedTransaction, t.Status)
+
+	return updatedTransaction, nil
 }
 
 func (uc *transactionUsecase) CreateTransaction(ctx context.Context, req *transaction.CreateTransactionRequest) (*transaction.TransactionResponse, error) {
-	if req.GetBuyerId() == "" || req.GetSkinId() == "" || req.GetAmount() <= 0 {
+	amount := models.MoneyFromProto(req.GetAmount())
+	if req.GetBuyerId() == "" || req.GetSkinId() == "" || amount.Units <= 0 {
 		return nil, errors.New("invalid request: buyer_id, skin_id and amount are required")
 	}
+	if !models.ValidCurrency(amount.Currency) {
+		return nil, fmt.Errorf("unsupported currency %q", amount.Currency)
+	}
 
 	buyerID, err := primitive.ObjectIDFromHex(req.GetBuyerId())
 	if err != nil {
@@ -133,23 +267,53 @@ func (uc *transactionUsecase) CreateTransaction(ctx context.Context, req *transa
 		return nil, fmt.Errorf("invalid skin_id: %v", err)
 	}
 
+	// Return the original transaction if this is a retry
+	conversions := models.ConversionsFromProto(req.GetConversions())
+	fingerprint := requestFingerprint("create", req.GetBuyerId(), req.GetSellerId(), req.GetSkinId(), amount, req.GetType(), req.GetDescription(), conversions)
+	existing, err := uc.findIdempotentTransaction(ctx, buyerID, req.GetIdempotencyKey(), fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return &transaction.TransactionResponse{
+			Transaction: existing.ToProto(),
+		}, nil
+	}
+
 	newTransaction := &models.Transaction{
 		BuyerID:     buyerID,
 		SellerID:    sellerID,
 		SkinID:      skinID,
-		Amount:      req.GetAmount(),
+		Amount:      amount,
+		Conversions: conversions,
 		Status:      models.StatusPending,
 		Type:        models.TypeFromProto(req.GetType()),
 		Description: req.GetDescription(),
 	}
+	if err := uc.applyFees(ctx, newTransaction, uc.skinRarity(ctx, req.GetSkinId())); err != nil {
+		return nil, err
+	}
+	if uc.assessRisk(ctx, newTransaction, nil).Decision == models.RiskBlock {
+		return nil, uc.recordBlockedTransaction(ctx, newTransaction)
+	}
+	uc.setIdempotencyKey(newTransaction, req.GetIdempotencyKey(), fingerprint)
 
-	createdTransaction, err := uc.transactionRepo.CreateTransaction(ctx, newTransaction)
+	createdTransaction, err := uc.createPendingTransaction(ctx, newTransaction)
+	if errors.Is(err, models.ErrDuplicateIdempotencyKey) {
+		// A concurrent retry created the transaction first
+		existing, err = uc.findIdempotentTransaction(ctx, buyerID, req.GetIdempotencyKey(), fingerprint)
+		if err == nil && existing != nil {
+			return &transaction.TransactionResponse{
+				Transaction: existing.ToProto(),
+			}, nil
+		}
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to create transaction: %v", err)
+		return nil, fmt.Errorf("failed to create transaction: %w", err)
 	}
 
 	// Invalidate related caches after creating new transaction
-	uc.invalidateTransactionCaches(createdTransaction.ID.Hex(), &buyerID, &skinID)
+	uc.invalidateTransactionCaches(createdTransaction)
 
 	return &transaction.TransactionResponse{
 		Transaction: createdTransaction.ToProto(),
@@ -177,8 +341,11 @@ func (uc *transactionUsecase) GetTransaction(ctx context.Context, req *transacti
 	}
 
 	trans, err := uc.transactionRepo.GetTransactionByID(ctx, objID)
+	if errors.Is(err, models.ErrTransactionNotFound) && req.GetIncludeArchived() {
+		trans, err = uc.transactionRepo.GetArchivedTransactionByID(ctx, objID)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get transaction: %v", err)
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
 	}
 
 	// Cache the result
@@ -199,31 +366,55 @@ func (uc *transactionUsecase) UpdateTransaction(ctx context.Context, req *transa
 		return nil, fmt.Errorf("invalid transaction id: %v", err)
 	}
 
-	update := bson.M{}
-	if req.Status != transaction.TransactionStatus_PENDING {
-		update["status"] = models.StatusFromProto(req.GetStatus())
+	current, err := uc.transactionRepo.GetTransactionByID(ctx, objID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
 	}
+
+	if req.GetExpectedVersion() != 0 && req.GetExpectedVersion() != current.Version {
+		return nil, models.ErrVersionConflict
+	}
+
+	update := bson.M{}
 	if req.GetDescription() != "" {
 		update["description"] = req.GetDescription()
 	}
 
-	if len(update) == 0 {
-		return nil, errors.New("no fields to update")
+	var updatedTransaction *models.Transaction
+	if req.Status != nil && models.StatusFromProto(req.GetStatus()) != current.Status {
+		next := models.StatusFromProto(req.GetStatus())
+		if next == models.StatusCompleted && current.CompletedByFlow() {
+			return nil, models.ErrCompletedByFlow
+		}
+		updatedTransaction, err = uc.transitionStatus(ctx, current, next, update, req.GetActorId(), req.GetReason())
+	} else {
+		if len(update) == 0 {
+			return nil, errors.New("no fields to update")
+		}
+		reason := req.GetReason()
+		if reason == "" {
+			reason = "description updated"
+		}
+		change := models.NewStatusChange(current.Status, current.Status, req.GetActorId(), reason)
+		updatedTransaction, err = uc.transactionRepo.UpdateTransaction(ctx, objID, current.Version, update, change)
+		if err == nil {
+			uc.publish(watch.EventUpdated, updatedTransaction, "")
+		}
 	}
-
-	updatedTransaction, err := uc.transactionRepo.UpdateTransaction(ctx, objID, update)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update transaction: %v", err)
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
 	}
 
 	// Invalidate caches after update
-	uc.invalidateTransactionCaches(req.GetId(), &updatedTransaction.BuyerID, &updatedTransaction.SkinID)
+	uc.invalidateTransactionCaches(updatedTransaction)
 
 	return &transaction.TransactionResponse{
 		Transaction: updatedTransaction.ToProto(),
 	}, nil
 }
 
+// DeleteTransaction soft deletes a transaction, hiding it from lists and stats
+// while keeping the record. A pending transaction is cancelled in the same write.
 func (uc *transactionUsecase) DeleteTransaction(ctx context.Context, req *transaction.GetTransactionRequest) (*transaction.DeleteResponse, error) {
 	if req.GetId() == "" {
 		return nil, errors.New("transaction id is required")
@@ -242,8 +433,25 @@ func (uc *transactionUsecase) DeleteTransaction(ctx context.Context, req *transa
 			Message: fmt.Sprintf("failed to find transaction: %v", err),
 		}, nil
 	}
+	if trans.IsDeleted() {
+		return nil, models.ErrAlreadyDeleted
+	}
+
+	update := bson.M{
+		"deleted_at": time.Now(),
+	}
+	if req.GetActorId() != "" {
+		update["deleted_by"] = req.GetActorId()
+	}
 
-	err = uc.transactionRepo.DeleteTransaction(ctx, objID)
+	var deleted *models.Transaction
+	if trans.Status == models.StatusPending {
+		// A deleted pending transaction must not keep the buyer's funds on hold
+		deleted, err = uc.transitionStatus(ctx, trans, models.StatusCancelled, update, req.GetActorId(), "deleted")
+	} else {
+		change := models.NewStatusChange(trans.Status, trans.Status, req.GetActorId(), "deleted")
+		deleted, err = uc.transactionRepo.UpdateTransaction(ctx, objID, trans.Version, update, change)
+	}
 	if err != nil {
 		return &transaction.DeleteResponse{
 			Success: false,
@@ -251,8 +459,10 @@ func (uc *transactionUsecase) DeleteTransaction(ctx context.Context, req *transa
 		}, nil
 	}
 
+	uc.publish(watch.EventDeleted, deleted, "")
+
 	// Invalidate caches after deletion
-	uc.invalidateTransactionCaches(req.GetId(), &trans.BuyerID, &trans.SkinID)
+	uc.invalidateTransactionCaches(trans)
 
 	return &transaction.DeleteResponse{
 		Success: true,
@@ -260,6 +470,47 @@ func (uc *transactionUsecase) DeleteTransaction(ctx context.Context, req *transa
 	}, nil
 }
 
+// RestoreTransaction undoes a soft delete. Only admins may restore transactions;
+// a pending transaction cancelled by the delete stays cancelled.
+func (uc *transactionUsecase) RestoreTransaction(ctx context.Context, req *transaction.RestoreTransactionRequest) (*transaction.TransactionResponse, error) {
+	if req.GetId() == "" || req.GetAdminToken() == "" {
+		return nil, errors.New("id and admin_token are required")
+	}
+
+	objID, err := primitive.ObjectIDFromHex(req.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction id: %v", err)
+	}
+
+	admin, err := uc.requireAdmin(ctx, req.GetAdminToken())
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := uc.transactionRepo.GetTransactionByID(ctx, objID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if !current.IsDeleted() {
+		return nil, models.ErrNotDeleted
+	}
+
+	change := models.NewStatusChange(current.Status, current.Status, admin, "restored")
+	restored, err := uc.transactionRepo.UpdateTransaction(ctx, objID, current.Version, bson.M{}, change, "deleted_at", "deleted_by")
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore transaction: %w", err)
+	}
+
+	uc.publish(watch.EventUpdated, restored, "")
+
+	// Invalidate caches after restoring
+	uc.invalidateTransactionCaches(restored)
+
+	return &transaction.TransactionResponse{
+		Transaction: restored.ToProto(),
+	}, nil
+}
+
 func (uc *transactionUsecase) ListTransactions(ctx context.Context, req *transaction.GetTransactionsByUserRequest) (*transaction.TransactionListResponse, error) {
 	return uc.GetTransactionsByUser(ctx, req)
 }
@@ -270,7 +521,7 @@ func (uc *transactionUsecase) GetTransactionsByUser(ctx context.Context, req *tr
 	}
 
 	// Generate cache key with all parameters
-	cacheKey := uc.getListCacheKey(userTransactionsCachePrefix, req.GetUserId(), req.GetStatus(), req.GetType(), req.GetLimit(), req.GetOffset())
+	cacheKey := uc.getListCacheKey(userTransactionsCachePrefix, req.GetUserId(), req.Status != nil, req.GetStatus(), req.Type != nil, req.GetType(), req.GetLimit(), req.GetOffset(), req.GetPageToken(), req.GetIncludeArchived())
 
 	// Try to get from cache first
 	if cached, found := uc.cache.Get(cacheKey); found {
@@ -284,10 +535,22 @@ func (uc *transactionUsecase) GetTransactionsByUser(ctx context.Context, req *tr
 		return nil, fmt.Errorf("invalid user id: %v", err)
 	}
 
-	status := models.StatusFromProto(req.GetStatus())
-	txType := models.TypeFromProto(req.GetType())
+	page, err := newPage(req.GetLimit(), req.GetOffset(), req.GetPageToken(), req.GetIncludeArchived())
+	if err != nil {
+		return nil, err
+	}
+
+	// Status and type only filter when set
+	var status models.TransactionStatus
+	if req.Status != nil {
+		status = models.StatusFromProto(req.GetStatus())
+	}
+	var txType models.TransactionType
+	if req.Type != nil {
+		txType = models.TypeFromProto(req.GetType())
+	}
 
-	transactions, totalCount, err := uc.transactionRepo.GetTransactionsByUserID(ctx, userID, status, txType, req.GetLimit(), req.GetOffset())
+	transactions, totalCount, err := uc.transactionRepo.GetTransactionsByUserID(ctx, userID, status, txType, page)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get transactions: %v", err)
 	}
@@ -298,12 +561,13 @@ func (uc *transactionUsecase) GetTransactionsByUser(ctx context.Context, req *tr
 	}
 
 	response := &transaction.TransactionListResponse{
-		Transactions: protoTransactions,
-		TotalCount:   int32(totalCount),
+		Transactions:  protoTransactions,
+		TotalCount:    int32(totalCount),
+		NextPageToken: nextPageToken(transactions, req.GetLimit()),
 	}
 
 	// Cache the result
-	uc.cache.Set(cacheKey, response, listCacheTTL)
+	uc.cache.Set(cacheKey, response, listCacheTTL, cache.UserTag(userID.Hex()))
 
 	return response, nil
 }
@@ -315,6 +579,9 @@ func (uc *transactionUsecase) GetTransactionsBySkin(ctx context.Context, req *tr
 
 	// Try to get from cache first
 	cacheKey := uc.getCacheKey(skinTransactionsCachePrefix, req.GetSkinId())
+	if req.GetLimit() > 0 || req.GetPageToken() != "" || req.GetIncludeArchived() {
+		cacheKey = uc.getListCacheKey(cacheKey, req.GetLimit(), req.GetPageToken(), req.GetIncludeArchived())
+	}
 	if cached, found := uc.cache.Get(cacheKey); found {
 		if response, ok := cached.(*transaction.TransactionListResponse); ok {
 			return response, nil
@@ -326,7 +593,12 @@ func (uc *transactionUsecase) GetTransactionsBySkin(ctx context.Context, req *tr
 		return nil, fmt.Errorf("invalid skin id: %v", err)
 	}
 
-	transactions, err := uc.transactionRepo.GetTransactionsBySkinID(ctx, skinID)
+	page, err := newPage(req.GetLimit(), 0, req.GetPageToken(), req.GetIncludeArchived())
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := uc.transactionRepo.GetTransactionsBySkinID(ctx, skinID, page)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get transactions: %v", err)
 	}
@@ -337,19 +609,20 @@ func (uc *transactionUsecase) GetTransactionsBySkin(ctx context.Context, req *tr
 	}
 
 	response := &transaction.TransactionListResponse{
-		Transactions: protoTransactions,
-		TotalCount:   int32(len(transactions)),
+		Transactions:  protoTransactions,
+		TotalCount:    int32(len(transactions)),
+		NextPageToken: nextPageToken(transactions, req.GetLimit()),
 	}
 
 	// Cache the result
-	uc.cache.Set(cacheKey, response, listCacheTTL)
+	uc.cache.Set(cacheKey, response, listCacheTTL, cache.SkinTag(req.GetSkinId()))
 
 	return response, nil
 }
 
 func (uc *transactionUsecase) GetTransactionsByStatus(ctx context.Context, req *transaction.GetTransactionsByStatusRequest) (*transaction.TransactionListResponse, error) {
 	// Generate cache key with parameters
-	cacheKey := uc.getListCacheKey(statusTransactionsCachePrefix, req.GetStatus(), req.GetLimit(), req.GetOffset())
+	cacheKey := uc.getListCacheKey(statusTransactionsCachePrefix, req.GetStatus(), req.GetLimit(), req.GetOffset(), req.GetPageToken(), req.GetIncludeArchived())
 
 	// Try to get from cache first
 	if cached, found := uc.cache.Get(cacheKey); found {
@@ -358,9 +631,14 @@ func (uc *transactionUsecase) GetTransactionsByStatus(ctx context.Context, req *
 		}
 	}
 
+	page, err := newPage(req.GetLimit(), req.GetOffset(), req.GetPageToken(), req.GetIncludeArchived())
+	if err != nil {
+		return nil, err
+	}
+
 	status := models.StatusFromProto(req.GetStatus())
 
-	transactions, totalCount, err := uc.transactionRepo.GetTransactionsByStatus(ctx, status, req.GetLimit(), req.GetOffset())
+	transactions, totalCount, err := uc.transactionRepo.GetTransactionsByStatus(ctx, status, page)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get transactions: %v", err)
 	}
@@ -371,54 +649,17 @@ func (uc *transactionUsecase) GetTransactionsByStatus(ctx context.Context, req *
 	}
 
 	response := &transaction.TransactionListResponse{
-		Transactions: protoTransactions,
-		TotalCount:   int32(totalCount),
+		Transactions:  protoTransactions,
+		TotalCount:    int32(totalCount),
+		NextPageToken: nextPageToken(transactions, req.GetLimit()),
 	}
 
 	// Cache the result
-	uc.cache.Set(cacheKey, response, listCacheTTL)
+	uc.cache.Set(cacheKey, response, listCacheTTL, cache.StatusTag(string(models.StatusFromProto(req.GetStatus()))))
 
 	return response, nil
 }
 
-func (uc *transactionUsecase) ProcessPurchase(ctx context.Context, req *transaction.ProcessPurchaseRequest) (*transaction.TransactionResponse, error) {
-	if req.GetBuyerId() == "" || req.GetSkinId() == "" {
-		return nil, errors.New("buyer_id and skin_id are required")
-	}
-
-	buyerID, err := primitive.ObjectIDFromHex(req.GetBuyerId())
-	if err != nil {
-		return nil, fmt.Errorf("invalid buyer_id: %v", err)
-	}
-
-	skinID, err := primitive.ObjectIDFromHex(req.GetSkinId())
-	if err != nil {
-		return nil, fmt.Errorf("invalid skin_id: %v", err)
-	}
-
-	newTransaction := &models.Transaction{
-		BuyerID:     buyerID,
-		SellerID:    primitive.NewObjectID(),
-		SkinID:      skinID,
-		Amount:      100.0,
-		Status:      models.StatusPending,
-		Type:        models.TypeBuy,
-		Description: "Purchase transaction",
-	}
-
-	createdTransaction, err := uc.transactionRepo.CreateTransaction(ctx, newTransaction)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create purchase transaction: %v", err)
-	}
-
-	// Invalidate related caches
-	uc.invalidateTransactionCaches(createdTransaction.ID.Hex(), &buyerID, &skinID)
-
-	return &transaction.TransactionResponse{
-		Transaction: createdTransaction.ToProto(),
-	}, nil
-}
-
 func (uc *transactionUsecase) CancelTransaction(ctx context.Context, req *transaction.CancelTransactionRequest) (*transaction.TransactionResponse, error) {
 	if req.GetId() == "" {
 		return nil, errors.New("transaction id is required")
@@ -429,20 +670,27 @@ func (uc *transactionUsecase) CancelTransaction(ctx context.Context, req *transa
 		return nil, fmt.Errorf("invalid transaction id: %v", err)
 	}
 
-	update := bson.M{
-		"status": models.StatusCancelled,
+	current, err := uc.transactionRepo.GetTransactionByID(ctx, objID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	if req.GetExpectedVersion() != 0 && req.GetExpectedVersion() != current.Version {
+		return nil, models.ErrVersionConflict
 	}
+
+	update := bson.M{}
 	if req.GetReason() != "" {
 		update["description"] = fmt.Sprintf("Cancelled: %s", req.GetReason())
 	}
 
-	updatedTransaction, err := uc.transactionRepo.UpdateTransaction(ctx, objID, update)
+	updatedTransaction, err := uc.transitionStatus(ctx, current, models.StatusCancelled, update, req.GetActorId(), req.GetReason())
 	if err != nil {
-		return nil, fmt.Errorf("failed to cancel transaction: %v", err)
+		return nil, fmt.Errorf("failed to cancel transaction: %w", err)
 	}
 
 	// Invalidate caches after cancellation
-	uc.invalidateTransactionCaches(req.GetId(), &updatedTransaction.BuyerID, &updatedTransaction.SkinID)
+	uc.invalidateTransactionCaches(updatedTransaction)
 
 	return &transaction.TransactionResponse{
 		Transaction: updatedTransaction.ToProto(),
@@ -450,14 +698,9 @@ func (uc *transactionUsecase) CancelTransaction(ctx context.Context, req *transa
 }
 
 func (uc *transactionUsecase) GetTransactionStats(ctx context.Context, req *transaction.GetTransactionStatsRequest) (*transaction.TransactionStatsResponse, error) {
-	// Generate cache key with parameters
-	cacheKey := uc.getListCacheKey(statsCachePrefix, req.GetUserId(), req.GetStartDate(), req.GetEndDate())
-
-	// Try to get from cache first
-	if cached, found := uc.cache.Get(cacheKey); found {
-		if response, ok := cached.(*transaction.TransactionStatsResponse); ok {
-			return response, nil
-		}
+	currency, err := uc.reportCurrency(req.GetCurrency())
+	if err != nil {
+		return nil, err
 	}
 
 	var userID *primitive.ObjectID
@@ -469,28 +712,61 @@ func (uc *transactionUsecase) GetTransactionStats(ctx context.Context, req *tran
 		userID = &objID
 	}
 
-	stats, err := uc.transactionRepo.GetTransactionStats(ctx, userID, req.GetStartDate(), req.GetEndDate())
+	dims, err := parseStatsDimensions(req.GetGroupBy(), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate cache key with parameters
+	cacheKey := uc.getListCacheKey(statsCachePrefix, req.GetUserId(), req.GetStartDate(), req.GetEndDate(), currency, dims)
+
+	// Try to get from cache first
+	if cached, found := uc.cache.Get(cacheKey); found {
+		if response, ok := cached.(*transaction.TransactionStatsResponse); ok {
+			return response, nil
+		}
+	}
+
+	stats, err := uc.transactionRepo.GetTransactionStats(ctx, userID, req.GetStartDate(), req.GetEndDate(), currency)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get transaction stats: %v", err)
 	}
 
+	groups, err := uc.statsGroups(ctx, userID, req.GetStartDate(), req.GetEndDate(), currency, dims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grouped transaction stats: %v", err)
+	}
+
 	response := &transaction.TransactionStatsResponse{
 		TotalTransactions:        stats.TotalTransactions,
-		TotalAmount:              stats.TotalAmount,
+		TotalAmount:              models.MajorUnits(stats.TotalAmount, currency),
 		SuccessfulTransactions:   stats.SuccessfulTransactions,
 		FailedTransactions:       stats.FailedTransactions,
-		AverageTransactionAmount: stats.AverageAmount,
+		CancelledTransactions:    stats.CancelledTransactions,
+		PendingTransactions:      stats.PendingTransactions,
+		AverageTransactionAmount: models.MajorUnits(stats.AverageAmount, currency),
+		MedianAmount:             models.MajorUnits(stats.MedianAmount, currency),
+		P90Amount:                models.MajorUnits(stats.P90Amount, currency),
+		P99Amount:                models.MajorUnits(stats.P99Amount, currency),
+		TotalFeeRevenue:          models.MajorUnits(stats.TotalFeeRevenue, currency),
+		Currency:                 currency,
+		Groups:                   groups,
+	}
+
+	// Cache the result with longer TTL since stats are expensive to compute.
+	// Stats of a user only change with their own transactions.
+	tag := cache.AllTag
+	if userID != nil {
+		tag = cache.UserTag(userID.Hex())
 	}
-
-	// Cache the result with longer TTL since stats are expensive to compute
-	uc.cache.Set(cacheKey, response, statsCacheTTL)
+	uc.cache.Set(cacheKey, response, statsCacheTTL, tag)
 
 	return response, nil
 }
 
 func (uc *transactionUsecase) GetAllTransactions(ctx context.Context, req *transaction.GetTransactionsByStatusRequest) (*transaction.TransactionListResponse, error) {
 	// Generate cache key with parameters
-	cacheKey := uc.getListCacheKey(allTransactionsCachePrefix, req.GetLimit(), req.GetOffset())
+	cacheKey := uc.getListCacheKey(allTransactionsCachePrefix, req.GetLimit(), req.GetOffset(), req.GetPageToken(), req.GetIncludeArchived())
 
 	// Try to get from cache first
 	if cached, found := uc.cache.Get(cacheKey); found {
@@ -499,7 +775,12 @@ func (uc *transactionUsecase) GetAllTransactions(ctx context.Context, req *trans
 		}
 	}
 
-	transactions, totalCount, err := uc.transactionRepo.GetAllTransactions(ctx, req.GetLimit(), req.GetOffset())
+	page, err := newPage(req.GetLimit(), req.GetOffset(), req.GetPageToken(), req.GetIncludeArchived())
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, totalCount, err := uc.transactionRepo.GetAllTransactions(ctx, page)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get all transactions: %v", err)
 	}
@@ -510,12 +791,13 @@ func (uc *transactionUsecase) GetAllTransactions(ctx context.Context, req *trans
 	}
 
 	response := &transaction.TransactionListResponse{
-		Transactions: protoTransactions,
-		TotalCount:   int32(totalCount),
+		Transactions:  protoTransactions,
+		TotalCount:    int32(totalCount),
+		NextPageToken: nextPageToken(transactions, req.GetLimit()),
 	}
 
 	// Cache the result
-	uc.cache.Set(cacheKey, response, listCacheTTL)
+	uc.cache.Set(cacheKey, response, listCacheTTL, cache.AllTag)
 
 	return response, nil
 }
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"cs2-marketplace-microservices/transaction-service/internal/repository/mongo"
+	"cs2-marketplace-microservices/transaction-service/internal/watch"
+	"cs2-marketplace-microservices/transaction-service/proto/transaction"
+	"errors"
+	"slices"
+	"time"
+)
+
+// watchHistorySize is the number of recent events kept for resuming watchers
+const watchHistorySize = 1000
+
+var eventTypesToProto = map[watch.EventType]transaction.TransactionEventType{
+	watch.EventCreated:       transaction.TransactionEventType_TRANSACTION_CREATED,
+	watch.EventUpdated:       transaction.TransactionEventType_TRANSACTION_UPDATED,
+	watch.EventStatusChanged: transaction.TransactionEventType_TRANSACTION_STATUS_CHANGED,
+	watch.EventDeleted:       transaction.TransactionEventType_TRANSACTION_DELETED,
+}
+
+// WatchTransactions sends the changes to the selected transactions to send
+// until ctx is done, the subscriber falls behind or send fails
+func (uc *transactionUsecase) WatchTransactions(ctx context.Context, req *transaction.WatchTransactionsRequest, send func(*transaction.TransactionEvent) error) error {
+	if req.GetTransactionId() == "" && req.GetUserId() == "" && req.GetSkinId() == "" {
+		return errors.New("transaction_id, user_id or skin_id is required")
+	}
+
+	sub, err := uc.watchers.Subscribe(watch.Filter{
+		TransactionID: req.GetTransactionId(),
+		UserID:        req.GetUserId(),
+		SkinID:        req.GetSkinId(),
+	}, req.GetResumeToken())
+	if err != nil {
+		return err
+	}
+	defer uc.watchers.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e, ok := <-sub.C:
+			if !ok {
+				if sub.Lagged() {
+					return watch.ErrSubscriberLagged
+				}
+				return nil
+			}
+			if err := send(uc.eventToProto(e)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// publish notifies NATS subscribers of a change to t. Watchers learn of it
+// from the change stream, through FeedWatchers.
+func (uc *transactionUsecase) publish(eventType watch.EventType, t *models.Transaction, previous models.TransactionStatus) {
+	uc.publishLifecycle(eventType, t, previous, time.Now())
+}
+
+// FeedWatchers publishes the changes of the transaction change stream to the
+// watchers of this replica until ctx is done or the stream fails. Every
+// replica reads the same stream, so watchers see the changes made by all of
+// them. A new call resumes after the last change published; if that change
+// is no longer available, the watchers are reset.
+func (uc *transactionUsecase) FeedWatchers(ctx context.Context) error {
+	after := uc.watchers.Position()
+	started := func(token string) {
+		if after == "" {
+			uc.watchers.Reset(token)
+		}
+	}
+
+	err := uc.transactionRepo.WatchTransactions(ctx, after, started, func(c *mongo.TransactionChange) error {
+		uc.watchers.Publish(changeToEvent(c))
+		return nil
+	})
+	if errors.Is(err, models.ErrChangeHistoryLost) {
+		uc.watchers.Reset("")
+	}
+	return err
+}
+
+// changeToEvent tells what kind of change a change stream event is
+func changeToEvent(c *mongo.TransactionChange) watch.Event {
+	e := watch.Event{
+		Token:       c.Token,
+		Type:        watch.EventUpdated,
+		Transaction: c.Transaction,
+		OccurredAt:  c.Transaction.UpdatedAt,
+	}
+
+	switch {
+	case c.Inserted:
+		e.Type = watch.EventCreated
+		e.OccurredAt = c.Transaction.CreatedAt
+	case slices.Contains(c.UpdatedFields, "deleted_at"):
+		e.Type = watch.EventDeleted
+	case c.StatusChange != nil && c.StatusChange.From != c.StatusChange.To:
+		e.Type = watch.EventStatusChanged
+		e.PreviousStatus = c.StatusChange.From
+		e.OccurredAt = c.StatusChange.At
+	}
+	return e
+}
+
+func (uc *transactionUsecase) eventToProto(e watch.Event) *transaction.TransactionEvent {
+	event := &transaction.TransactionEvent{
+		Type:        eventTypesToProto[e.Type],
+		Transaction: e.Transaction.ToProto(),
+		OccurredAt:  e.OccurredAt.Format(time.RFC3339),
+		ResumeToken: uc.watchers.Token(e),
+	}
+	if e.Type == watch.EventStatusChanged {
+		event.PreviousStatus = models.StatusToProto(e.PreviousStatus)
+	}
+	return event
+}
@@ -0,0 +1,285 @@
+package watch
+
+import (
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrResumeTokenExpired is returned when the events after a resume token
+	// are no longer retained, e.g. after a long disconnect
+	ErrResumeTokenExpired = errors.New("resume token expired")
+	ErrInvalidResumeToken = errors.New("invalid resume token")
+	// ErrSubscriberLagged is returned to subscribers dropped for falling behind
+	ErrSubscriberLagged = errors.New("subscriber fell behind, resume from the last resume token")
+	// ErrFeedNotStarted is returned when resuming before the hub has read
+	// the change stream
+	ErrFeedNotStarted = errors.New("watch feed has not started yet, retry")
+)
+
+type EventType int
+
+const (
+	EventCreated EventType = iota
+	EventUpdated
+	EventStatusChanged
+	EventDeleted
+)
+
+// Event is a change to a transaction
+type Event struct {
+	Token          string // change stream resume token of the change
+	Type           EventType
+	Transaction    models.Transaction
+	PreviousStatus models.TransactionStatus
+	OccurredAt     time.Time
+}
+
+// Filter selects the events of a subscription; empty fields match everything
+type Filter struct {
+	TransactionID string
+	UserID        string
+	SkinID        string
+}
+
+func (f Filter) matches(t *models.Transaction) bool {
+	if f.TransactionID != "" && t.ID.Hex() != f.TransactionID {
+		return false
+	}
+	if f.UserID != "" && t.BuyerID.Hex() != f.UserID && t.SellerID.Hex() != f.UserID {
+		return false
+	}
+	if f.SkinID != "" && !involvesSkin(t, f.SkinID) {
+		return false
+	}
+	return true
+}
+
+// involvesSkin reports whether t sells skinID or trades it in either direction
+func involvesSkin(t *models.Transaction, skinID string) bool {
+	if t.SkinID.Hex() == skinID {
+		return true
+	}
+	for _, ids := range [][]primitive.ObjectID{t.BuyerSkinIDs, t.SellerSkinIDs} {
+		for _, id := range ids {
+			if id.Hex() == skinID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// subscriberBuffer is the number of events a subscriber may lag behind
+// before it is dropped and has to resume
+const subscriberBuffer = 64
+
+// Subscription receives the events matching its filter on C. C is closed
+// when the subscription ends; Lagged reports whether it ended because the
+// subscriber could not keep up.
+type Subscription struct {
+	C <-chan Event
+
+	ch     chan Event
+	filter Filter
+	after  string // events up to this token were already seen on another replica
+	lagged bool
+}
+
+// Lagged reports whether the subscription was dropped for falling behind.
+// It may only be called after C is closed.
+func (s *Subscription) Lagged() bool {
+	return s.lagged
+}
+
+// Hub fans out transaction events to subscribers and keeps the most recent
+// events so reconnecting subscribers can resume where they left off.
+//
+// Events come from the transaction change stream, which every replica reads
+// in the same order, so an event has the same resume token on every replica
+// and a subscriber can resume on any of them. Resume tokens sort in stream
+// order when compared as strings.
+type Hub struct {
+	mu          sync.Mutex
+	origin      string // the history is complete from here on; empty until the feed starts
+	history     []Event
+	historySize int
+	subs        map[*Subscription]struct{}
+}
+
+func NewHub(historySize int) *Hub {
+	return &Hub{
+		historySize: historySize,
+		subs:        make(map[*Subscription]struct{}),
+	}
+}
+
+// Position returns the token of the last event published, or of the start of
+// the feed if there was none. It is empty until the feed starts.
+func (h *Hub) Position() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.position()
+}
+
+// Reset forgets the retained events and starts the history at origin. An
+// empty origin stops resuming until the next Reset. Subscribers of an earlier
+// feed are dropped, since events between the feeds may be lost.
+func (h *Hub) Reset(origin string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.origin != "" {
+		for sub := range h.subs {
+			sub.lagged = true
+			h.remove(sub)
+		}
+	}
+	h.origin = origin
+	h.history = nil
+}
+
+// Publish delivers e to the matching subscribers and retains it for resuming
+func (h *Hub) Publish(e Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.history = append(h.history, e)
+	if len(h.history) > h.historySize {
+		evicted := len(h.history) - h.historySize
+		h.origin = h.history[evicted-1].Token
+		h.history = h.history[evicted:]
+	}
+
+	for sub := range h.subs {
+		if sub.after != "" {
+			if e.Token <= sub.after {
+				continue
+			}
+			sub.after = ""
+		}
+		if !sub.filter.matches(&e.Transaction) {
+			continue
+		}
+		select {
+		case sub.ch <- e:
+		default:
+			// The subscriber is too slow; drop it so it resumes from its last token
+			sub.lagged = true
+			h.remove(sub)
+		}
+	}
+}
+
+// Subscribe starts a subscription. With a resume token the retained events
+// after the token are delivered first.
+func (h *Hub) Subscribe(filter Filter, resumeToken string) (*Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var backlog []Event
+	var after string
+	if resumeToken != "" {
+		token, err := parseToken(resumeToken)
+		if err != nil {
+			return nil, err
+		}
+		backlog, after, err = h.eventsAfter(token, filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ch := make(chan Event, subscriberBuffer+len(backlog))
+	for _, e := range backlog {
+		ch <- e
+	}
+
+	sub := &Subscription{C: ch, ch: ch, filter: filter, after: after}
+	h.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+// Unsubscribe ends a subscription and closes its channel
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.remove(sub)
+}
+
+// Token returns the resume token pointing just after e
+func (h *Hub) Token(e Event) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(e.Token))
+}
+
+func (h *Hub) remove(sub *Subscription) {
+	if _, ok := h.subs[sub]; !ok {
+		return
+	}
+	delete(h.subs, sub)
+	close(sub.ch)
+}
+
+func (h *Hub) position() string {
+	if len(h.history) > 0 {
+		return h.history[len(h.history)-1].Token
+	}
+	return h.origin
+}
+
+// eventsAfter returns the retained events after token. A token this replica
+// has not reached yet returns no events but the token to skip up to.
+func (h *Hub) eventsAfter(token string, filter Filter) ([]Event, string, error) {
+	if h.origin == "" {
+		return nil, "", ErrFeedNotStarted
+	}
+	// Events between the token and the oldest retained event are lost
+	if token < h.origin {
+		return nil, "", ErrResumeTokenExpired
+	}
+	if token > h.position() {
+		return nil, token, nil
+	}
+
+	start := -1
+	if token == h.origin {
+		start = 0
+	}
+	for i, e := range h.history {
+		if e.Token == token {
+			start = i + 1
+			break
+		}
+	}
+	if start < 0 {
+		return nil, "", ErrInvalidResumeToken
+	}
+
+	var events []Event
+	for _, e := range h.history[start:] {
+		if filter.matches(&e.Transaction) {
+			events = append(events, e)
+		}
+	}
+	return events, "", nil
+}
+
+// parseToken returns the change stream token of a resume token
+func parseToken(resumeToken string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(resumeToken)
+	if err != nil || len(raw) == 0 {
+		return "", ErrInvalidResumeToken
+	}
+	if _, err := hex.DecodeString(string(raw)); err != nil {
+		return "", ErrInvalidResumeToken
+	}
+	return string(raw), nil
+}
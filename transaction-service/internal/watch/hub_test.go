@@ -0,0 +1,271 @@
+package watch
+
+import (
+	"cs2-marketplace-microservices/transaction-service/internal/models"
+	"errors"
+	"fmt"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// streamToken returns the i-th change stream token; like real tokens they are
+// hex and sort in stream order
+func streamToken(i int) string {
+	return fmt.Sprintf("%08x", i)
+}
+
+func event(i int, t models.Transaction) Event {
+	return Event{Token: streamToken(i), Type: EventUpdated, Transaction: t}
+}
+
+// received returns the events already delivered to sub
+func received(sub *Subscription) []string {
+	var tokens []string
+	for {
+		select {
+		case e, ok := <-sub.C:
+			if !ok {
+				return tokens
+			}
+			tokens = append(tokens, e.Token)
+		default:
+			return tokens
+		}
+	}
+}
+
+func equalTokens(got []string, want ...int) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i, w := range want {
+		if got[i] != streamToken(w) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFilterMatches(t *testing.T) {
+	buyer, seller, skin, traded := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+	sale := models.Transaction{ID: primitive.NewObjectID(), BuyerID: buyer, SellerID: seller, SkinID: skin}
+	trade := models.Transaction{ID: primitive.NewObjectID(), BuyerID: buyer, SellerID: seller, SellerSkinIDs: []primitive.ObjectID{traded}}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		t      models.Transaction
+		want   bool
+	}{
+		{"empty filter", Filter{}, sale, true},
+		{"transaction", Filter{TransactionID: sale.ID.Hex()}, sale, true},
+		{"other transaction", Filter{TransactionID: trade.ID.Hex()}, sale, false},
+		{"buyer", Filter{UserID: buyer.Hex()}, sale, true},
+		{"seller", Filter{UserID: seller.Hex()}, sale, true},
+		{"other user", Filter{UserID: primitive.NewObjectID().Hex()}, sale, false},
+		{"sold skin", Filter{SkinID: skin.Hex()}, sale, true},
+		{"traded skin", Filter{SkinID: traded.Hex()}, trade, true},
+		{"other skin", Filter{SkinID: traded.Hex()}, sale, false},
+		{"all fields", Filter{TransactionID: sale.ID.Hex(), UserID: buyer.Hex(), SkinID: skin.Hex()}, sale, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.filter.matches(&tt.t); got != tt.want {
+			t.Errorf("%s: matches = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPublish(t *testing.T) {
+	hub := NewHub(10)
+	hub.Reset(streamToken(0))
+
+	user := primitive.NewObjectID()
+	all, err := hub.Subscribe(Filter{}, "")
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	mine, err := hub.Subscribe(Filter{UserID: user.Hex()}, "")
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	hub.Publish(event(1, models.Transaction{BuyerID: user}))
+	hub.Publish(event(2, models.Transaction{BuyerID: primitive.NewObjectID()}))
+	hub.Publish(event(3, models.Transaction{SellerID: user}))
+
+	if got := received(all); !equalTokens(got, 1, 2, 3) {
+		t.Errorf("unfiltered subscriber received %v", got)
+	}
+	if got := received(mine); !equalTokens(got, 1, 3) {
+		t.Errorf("filtered subscriber received %v", got)
+	}
+	if got, want := hub.Position(), streamToken(3); got != want {
+		t.Errorf("Position = %q, want %q", got, want)
+	}
+
+	hub.Unsubscribe(all)
+	if _, ok := <-all.C; ok {
+		t.Error("channel still open after Unsubscribe")
+	}
+	if all.Lagged() {
+		t.Error("unsubscribed subscriber reported as lagged")
+	}
+}
+
+func TestResume(t *testing.T) {
+	hub := NewHub(10)
+	hub.Reset(streamToken(0))
+	for i := 1; i <= 4; i++ {
+		hub.Publish(event(i, models.Transaction{}))
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		want  []int
+	}{
+		{"from origin", hub.Token(Event{Token: streamToken(0)}), []int{1, 2, 3, 4, 5}},
+		{"from an event", hub.Token(event(2, models.Transaction{})), []int{3, 4, 5}},
+		{"from the position", hub.Token(event(4, models.Transaction{})), []int{5}},
+	}
+
+	var subs []*Subscription
+	for _, tt := range tests {
+		sub, err := hub.Subscribe(Filter{}, tt.token)
+		if err != nil {
+			t.Fatalf("%s: Subscribe: %v", tt.name, err)
+		}
+		subs = append(subs, sub)
+	}
+
+	hub.Publish(event(5, models.Transaction{}))
+
+	for i, tt := range tests {
+		if got := received(subs[i]); !equalTokens(got, tt.want...) {
+			t.Errorf("%s: received %v, want tokens %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestResumeFiltersBacklog(t *testing.T) {
+	hub := NewHub(10)
+	hub.Reset(streamToken(0))
+
+	skin := primitive.NewObjectID()
+	hub.Publish(event(1, models.Transaction{SkinID: skin}))
+	hub.Publish(event(2, models.Transaction{SkinID: primitive.NewObjectID()}))
+	hub.Publish(event(3, models.Transaction{BuyerSkinIDs: []primitive.ObjectID{skin}}))
+
+	sub, err := hub.Subscribe(Filter{SkinID: skin.Hex()}, hub.Token(Event{Token: streamToken(0)}))
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	if got := received(sub); !equalTokens(got, 1, 3) {
+		t.Errorf("received %v", got)
+	}
+}
+
+func TestResumeAhead(t *testing.T) {
+	// A subscriber moving from a replica further along the stream skips the
+	// events it already saw there
+	hub := NewHub(10)
+	hub.Reset(streamToken(0))
+	hub.Publish(event(1, models.Transaction{}))
+
+	sub, err := hub.Subscribe(Filter{}, hub.Token(event(3, models.Transaction{})))
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	for i := 2; i <= 5; i++ {
+		hub.Publish(event(i, models.Transaction{}))
+	}
+
+	if got := received(sub); !equalTokens(got, 4, 5) {
+		t.Errorf("received %v", got)
+	}
+}
+
+func TestResumeErrors(t *testing.T) {
+	hub := NewHub(2)
+	if _, err := hub.Subscribe(Filter{}, hub.Token(event(1, models.Transaction{}))); !errors.Is(err, ErrFeedNotStarted) {
+		t.Errorf("before the feed started: Subscribe error = %v, want ErrFeedNotStarted", err)
+	}
+
+	hub.Reset(streamToken(0))
+	hub.Publish(event(2, models.Transaction{}))
+	hub.Publish(event(4, models.Transaction{}))
+	hub.Publish(event(6, models.Transaction{}))
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"evicted origin", hub.Token(Event{Token: streamToken(0)}), ErrResumeTokenExpired},
+		{"unknown token", hub.Token(event(5, models.Transaction{})), ErrInvalidResumeToken},
+		{"not base64", "!!!", ErrInvalidResumeToken},
+		{"not hex", hub.Token(Event{Token: "zz"}), ErrInvalidResumeToken},
+	}
+
+	for _, tt := range tests {
+		if _, err := hub.Subscribe(Filter{}, tt.token); !errors.Is(err, tt.want) {
+			t.Errorf("%s: Subscribe error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	// The oldest retained event can still be resumed from
+	if _, err := hub.Subscribe(Filter{}, hub.Token(event(2, models.Transaction{}))); err != nil {
+		t.Errorf("resuming from the new origin: %v", err)
+	}
+}
+
+func TestLaggedSubscriber(t *testing.T) {
+	hub := NewHub(10)
+	hub.Reset(streamToken(0))
+
+	sub, err := hub.Subscribe(Filter{}, "")
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	for i := 1; i <= subscriberBuffer+1; i++ {
+		hub.Publish(event(i, models.Transaction{}))
+	}
+
+	got := received(sub)
+	if len(got) != subscriberBuffer {
+		t.Errorf("received %d events, want %d", len(got), subscriberBuffer)
+	}
+	if _, ok := <-sub.C; ok {
+		t.Fatal("channel still open after falling behind")
+	}
+	if !sub.Lagged() {
+		t.Error("Lagged = false after falling behind")
+	}
+}
+
+func TestResetDropsSubscribers(t *testing.T) {
+	hub := NewHub(10)
+	hub.Reset(streamToken(0))
+	hub.Publish(event(1, models.Transaction{}))
+
+	sub, err := hub.Subscribe(Filter{}, "")
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	hub.Reset(streamToken(10))
+	if _, ok := <-sub.C; ok {
+		t.Fatal("channel still open after Reset")
+	}
+	if !sub.Lagged() {
+		t.Error("Lagged = false after Reset")
+	}
+	if got, want := hub.Position(), streamToken(10); got != want {
+		t.Errorf("Position = %q, want %q", got, want)
+	}
+	if _, err := hub.Subscribe(Filter{}, hub.Token(event(1, models.Transaction{}))); !errors.Is(err, ErrResumeTokenExpired) {
+		t.Errorf("resuming from the earlier feed: Subscribe error = %v, want ErrResumeTokenExpired", err)
+	}
+}
@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/usecase"
+	"log"
+	"time"
+)
+
+// Archiver periodically moves old terminal transactions into the archive
+type Archiver struct {
+	uc       usecase.TransactionUsecase
+	age      time.Duration
+	interval time.Duration
+}
+
+func NewArchiver(uc usecase.TransactionUsecase, age, interval time.Duration) *Archiver {
+	return &Archiver{
+		uc:       uc,
+		age:      age,
+		interval: interval,
+	}
+}
+
+// Run archives old transactions every interval until ctx is done
+func (a *Archiver) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			archived, err := a.uc.ArchiveTransactions(ctx, a.age)
+			if err != nil {
+				log.Printf("Archiver: %v", err)
+			}
+			if archived > 0 {
+				log.Printf("Archiver: archived %d transactions older than %s", archived, a.age)
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package worker
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/usecase"
+	"log"
+	"time"
+)
+
+// LeaderboardRefresher periodically recomputes the cached leaderboards
+type LeaderboardRefresher struct {
+	uc       usecase.TransactionUsecase
+	interval time.Duration
+}
+
+func NewLeaderboardRefresher(uc usecase.TransactionUsecase, interval time.Duration) *LeaderboardRefresher {
+	return &LeaderboardRefresher{
+		uc:       uc,
+		interval: interval,
+	}
+}
+
+// Run refreshes the leaderboards right away and then every interval until ctx is done
+func (r *LeaderboardRefresher) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.uc.RefreshLeaderboards(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Leaderboard refresher: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/usecase"
+	"log"
+	"time"
+)
+
+// Reaper periodically fails transactions stuck in PENDING and trade offers
+// that were never accepted
+type Reaper struct {
+	uc           usecase.TransactionUsecase
+	timeout      time.Duration
+	tradeTimeout time.Duration
+	interval     time.Duration
+}
+
+func NewReaper(uc usecase.TransactionUsecase, timeout, tradeTimeout, interval time.Duration) *Reaper {
+	return &Reaper{
+		uc:           uc,
+		timeout:      timeout,
+		tradeTimeout: tradeTimeout,
+		interval:     interval,
+	}
+}
+
+// Run reaps stale transactions every interval until ctx is done
+func (r *Reaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reaped, err := r.uc.ReapStalePendingTransactions(ctx, r.timeout, r.tradeTimeout)
+			if err != nil {
+				log.Printf("Reaper: %v", err)
+			}
+			if reaped > 0 {
+				log.Printf("Reaper: failed %d stale pending transactions and trade offers", reaped)
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package worker
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/usecase"
+	"log"
+	"time"
+)
+
+// WatchFeed keeps the watchers of this replica fed from the transaction
+// change stream, reopening the stream when it fails
+type WatchFeed struct {
+	uc    usecase.TransactionUsecase
+	retry time.Duration
+}
+
+func NewWatchFeed(uc usecase.TransactionUsecase, retry time.Duration) *WatchFeed {
+	return &WatchFeed{
+		uc:    uc,
+		retry: retry,
+	}
+}
+
+// Run feeds the watchers until ctx is done
+func (f *WatchFeed) Run(ctx context.Context) {
+	for {
+		err := f.uc.FeedWatchers(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Watch feed: %v, reopening in %s", err, f.retry)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(f.retry):
+		}
+	}
+}
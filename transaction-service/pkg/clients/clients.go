@@ -0,0 +1,48 @@
+package clients
+
+import (
+	"cs2-marketplace-microservices/transaction-service/proto/inventory"
+	"cs2-marketplace-microservices/transaction-service/proto/user"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Clients holds the gRPC clients for the services the transaction service depends on
+type Clients struct {
+	Inventory inventory.InventoryServiceClient
+	User      user.UserServiceClient
+
+	conns []*grpc.ClientConn
+}
+
+// New dials the inventory and user services
+func New(inventoryAddr, userAddr string) (*Clients, error) {
+	inventoryConn, err := grpc.NewClient(inventoryAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	userConn, err := grpc.NewClient(userAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		inventoryConn.Close()
+		return nil, err
+	}
+
+	log.Printf("Inventory service client targeting %s", inventoryAddr)
+	log.Printf("User service client targeting %s", userAddr)
+
+	return &Clients{
+		Inventory: inventory.NewInventoryServiceClient(inventoryConn),
+		User:      user.NewUserServiceClient(userConn),
+		conns:     []*grpc.ClientConn{inventoryConn, userConn},
+	}, nil
+}
+
+// Close closes all underlying connections
+func (c *Clients) Close() {
+	for _, conn := range c.conns {
+		conn.Close()
+	}
+}
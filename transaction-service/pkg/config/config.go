@@ -1,24 +1,149 @@
 package config
 
 import (
+	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	MongoURI   string
-	ServerPort string
-	DBName     string
+	MongoURI             string
+	ServerPort           string
+	MetricsPort          string // serves /metrics and /health
+	DBName               string
+	InventoryServiceAddr string
+	UserServiceAddr      string
+
+	// Currency of stats and of the fee schedule amounts, as an ISO 4217 code
+	Currency string
+
+	// How long an idempotency key is remembered after its transaction is created
+	IdempotencyKeyRetention time.Duration
+
+	// Marketplace fee schedule
+	FeePercent        float64
+	FeeFixed          float64
+	FeeMinimum        float64
+	FeeRarityPercents map[string]float64  // FEE_RARITY_PERCENTS=Covert:3,Classified:4
+	FeePriceTiers     map[float64]float64 // FEE_PRICE_TIERS=100:4,1000:3 (min amount:percent)
+	FeeExemptUserIDs  []string
+	FeeExemptAdmins   bool
+
+	// PENDING transactions older than PendingTimeout are failed by a background
+	// reaper that runs every ReaperInterval
+	PendingTimeout time.Duration
+	ReaperInterval time.Duration
+
+	// Trade offers not accepted within TradeOfferTimeout are failed by the reaper
+	TradeOfferTimeout time.Duration
+
+	// Terminal transactions not updated for ArchiveAfter are moved to the
+	// archive collection by a background archiver that runs every ArchiveInterval
+	ArchiveAfter    time.Duration
+	ArchiveInterval time.Duration
+
+	// Leaderboards are recomputed every LeaderboardRefreshInterval
+	LeaderboardRefreshInterval time.Duration
+
+	// Watchers are fed from the transaction change stream, which is reopened
+	// WatchFeedRetry after it fails
+	WatchFeedRetry time.Duration
+
+	// SMTP server receipts are emailed through. Without a host, emails are
+	// only printed.
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	EmailFrom    string
+
+	// Risk rules run in order before transactions are created. Each rule
+	// decides allow, review or block when it triggers.
+	RiskRules                []string // RISK_RULES=velocity,ping_pong,price_spike,new_account
+	RiskVelocityWindow       time.Duration
+	RiskVelocityMax          int64
+	RiskVelocityDecision     string
+	RiskPingPongWindow       time.Duration
+	RiskPingPongMax          int64
+	RiskPingPongDecision     string
+	RiskPriceSpikeWindow     time.Duration
+	RiskPriceSpikeMultiplier float64
+	RiskPriceSpikeDecision   string
+	RiskNewAccountAge        time.Duration
+	RiskNewAccountDecision   string
+
+	// Lifecycle events are published to NATS from a queue of
+	// EventQueueSize messages. A failed publish is attempted up to
+	// EventPublishAttempts times, waiting EventRetryBackoff, doubled after
+	// each attempt, in between.
+	NATSURL              string
+	EventQueueSize       int64
+	EventPublishAttempts int64
+	EventRetryBackoff    time.Duration
 }
 
 func LoadConfig() *Config {
 	_ = godotenv.Load()
 
 	return &Config{
-		MongoURI:   getEnv("MONGO_URI", "mongodb://localhost:27017"),
-		ServerPort: getEnv("SERVER_PORT", ":50053"),
-		DBName:     getEnv("DB_NAME", "cs2_transactions"),
+		MongoURI:             getEnv("MONGO_URI", "mongodb://localhost:27017"),
+		ServerPort:           getEnv("SERVER_PORT", ":50053"),
+		MetricsPort:          getEnv("METRICS_PORT", ":8083"),
+		DBName:               getEnv("DB_NAME", "cs2_transactions"),
+		InventoryServiceAddr: getEnv("INVENTORY_SERVICE_ADDR", "localhost:50051"),
+		UserServiceAddr:      getEnv("USER_SERVICE_ADDR", "localhost:50052"),
+
+		Currency: getEnv("CURRENCY", "USD"),
+
+		IdempotencyKeyRetention: getDurationEnv("IDEMPOTENCY_KEY_RETENTION", 24*time.Hour),
+
+		FeePercent:        getFloatEnv("FEE_PERCENT", 5),
+		FeeFixed:          getFloatEnv("FEE_FIXED", 0),
+		FeeMinimum:        getFloatEnv("FEE_MINIMUM", 0.01),
+		FeeRarityPercents: getPairsEnv("FEE_RARITY_PERCENTS"),
+		FeePriceTiers:     getPriceTiersEnv("FEE_PRICE_TIERS"),
+		FeeExemptUserIDs:  getListEnv("FEE_EXEMPT_USER_IDS"),
+		FeeExemptAdmins:   getBoolEnv("FEE_EXEMPT_ADMINS", true),
+
+		PendingTimeout: getDurationEnv("PENDING_TIMEOUT", 15*time.Minute),
+		ReaperInterval: getDurationEnv("REAPER_INTERVAL", time.Minute),
+
+		TradeOfferTimeout: getDurationEnv("TRADE_OFFER_TIMEOUT", 72*time.Hour),
+
+		ArchiveAfter:    getDurationEnv("ARCHIVE_AFTER", 90*24*time.Hour),
+		ArchiveInterval: getDurationEnv("ARCHIVE_INTERVAL", time.Hour),
+
+		LeaderboardRefreshInterval: getDurationEnv("LEADERBOARD_REFRESH_INTERVAL", 5*time.Minute),
+
+		WatchFeedRetry: getDurationEnv("WATCH_FEED_RETRY", 5*time.Second),
+
+		SMTPHost:     getEnv("SMTP_HOST", ""),
+		SMTPPort:     getEnv("SMTP_PORT", "465"),
+		SMTPUsername: getEnv("SMTP_USERNAME", ""),
+		SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+		EmailFrom:    getEnv("EMAIL_FROM", ""),
+
+		RiskRules:                getListEnv("RISK_RULES"),
+		RiskVelocityWindow:       getDurationEnv("RISK_VELOCITY_WINDOW", time.Hour),
+		RiskVelocityMax:          getIntEnv("RISK_VELOCITY_MAX", 30),
+		RiskVelocityDecision:     getEnv("RISK_VELOCITY_DECISION", "review"),
+		RiskPingPongWindow:       getDurationEnv("RISK_PING_PONG_WINDOW", 7*24*time.Hour),
+		RiskPingPongMax:          getIntEnv("RISK_PING_PONG_MAX", 2),
+		RiskPingPongDecision:     getEnv("RISK_PING_PONG_DECISION", "block"),
+		RiskPriceSpikeWindow:     getDurationEnv("RISK_PRICE_SPIKE_WINDOW", 30*24*time.Hour),
+		RiskPriceSpikeMultiplier: getFloatEnv("RISK_PRICE_SPIKE_MULTIPLIER", 3),
+		RiskPriceSpikeDecision:   getEnv("RISK_PRICE_SPIKE_DECISION", "review"),
+		RiskNewAccountAge:        getDurationEnv("RISK_NEW_ACCOUNT_AGE", 24*time.Hour),
+		RiskNewAccountDecision:   getEnv("RISK_NEW_ACCOUNT_DECISION", "review"),
+
+		NATSURL:              getEnv("NATS_URL", "nats://localhost:4222"),
+		EventQueueSize:       getIntEnv("EVENT_QUEUE_SIZE", 10000),
+		EventPublishAttempts: getIntEnv("EVENT_PUBLISH_ATTEMPTS", 5),
+		EventRetryBackoff:    getDurationEnv("EVENT_RETRY_BACKOFF", 500*time.Millisecond),
 	}
 }
 
@@ -29,3 +154,103 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Invalid duration %q for %s, using default %s", value, key, defaultValue)
+		return defaultValue
+	}
+	return duration
+}
+
+func getFloatEnv(key string, defaultValue float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Printf("Invalid number %q for %s, using default %v", value, key, defaultValue)
+		return defaultValue
+	}
+	return f
+}
+
+func getIntEnv(key string, defaultValue int64) int64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		log.Printf("Invalid integer %q for %s, using default %v", value, key, defaultValue)
+		return defaultValue
+	}
+	return i
+}
+
+func getBoolEnv(key string, defaultValue bool) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Printf("Invalid boolean %q for %s, using default %v", value, key, defaultValue)
+		return defaultValue
+	}
+	return b
+}
+
+// getListEnv reads a comma separated list
+func getListEnv(key string) []string {
+	var list []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// getPairsEnv reads a comma separated list of name:number pairs
+func getPairsEnv(key string) map[string]float64 {
+	pairs := make(map[string]float64)
+	for _, item := range getListEnv(key) {
+		name, value, found := strings.Cut(item, ":")
+		if !found {
+			log.Printf("Ignoring invalid entry %q in %s", item, key)
+			continue
+		}
+
+		f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			log.Printf("Ignoring invalid entry %q in %s", item, key)
+			continue
+		}
+		pairs[strings.TrimSpace(name)] = f
+	}
+	return pairs
+}
+
+func getPriceTiersEnv(key string) map[float64]float64 {
+	tiers := make(map[float64]float64)
+	for minAmount, percent := range getPairsEnv(key) {
+		f, err := strconv.ParseFloat(minAmount, 64)
+		if err != nil {
+			log.Printf("Ignoring invalid tier %q in %s", minAmount, key)
+			continue
+		}
+		tiers[f] = percent
+	}
+	return tiers
+}
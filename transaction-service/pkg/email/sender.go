@@ -0,0 +1,140 @@
+package email
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"mime/multipart"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+)
+
+// Sender interface defines email sending capabilities
+type Sender interface {
+	SendEmail(to, subject, body string) error
+	// SendMultipartEmail sends a plain text and an HTML version of the same
+	// message; mail clients show the HTML one if they can
+	SendMultipartEmail(to, subject, text, html string) error
+}
+
+// SMTPSender implements Sender over SMTP with implicit TLS
+type SMTPSender struct {
+	from     string
+	username string
+	password string
+	smtpHost string
+	smtpPort string
+}
+
+// NewSMTPSender creates a new SMTP email sender
+func NewSMTPSender(host, port, username, password, from string) *SMTPSender {
+	if from == "" {
+		from = username
+	}
+	return &SMTPSender{
+		from:     from,
+		username: username,
+		password: password,
+		smtpHost: host,
+		smtpPort: port,
+	}
+}
+
+// SendEmail sends an HTML email
+func (s *SMTPSender) SendEmail(to, subject, body string) error {
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-version: 1.0;\r\nContent-Type: text/html; charset=\"UTF-8\";\r\n\r\n%s",
+		s.from, to, subject, body)
+
+	return s.send(to, msg)
+}
+
+// SendMultipartEmail sends a multipart/alternative email with a plain text
+// and an HTML part
+func (s *SMTPSender) SendMultipartEmail(to, subject, text, html string) error {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+
+	// Parts go from the plainest to the richest version
+	parts := []struct{ contentType, content string }{
+		{"text/plain; charset=\"UTF-8\"", text},
+		{"text/html; charset=\"UTF-8\"", html},
+	}
+	for _, part := range parts {
+		w, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {part.contentType}})
+		if err != nil {
+			return fmt.Errorf("failed to create message part: %w", err)
+		}
+		if _, err := w.Write([]byte(part.content)); err != nil {
+			return fmt.Errorf("failed to write message part: %w", err)
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return fmt.Errorf("failed to close message: %w", err)
+	}
+
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-version: 1.0;\r\nContent-Type: multipart/alternative; boundary=\"%s\";\r\n\r\n%s",
+		s.from, to, subject, mw.Boundary(), body.String())
+
+	return s.send(to, msg)
+}
+
+// send delivers a complete message to the recipients in to
+func (s *SMTPSender) send(to, msg string) error {
+	auth := smtp.PlainAuth("", s.username, s.password, s.smtpHost)
+
+	conn, err := tls.Dial("tcp", net.JoinHostPort(s.smtpHost, s.smtpPort), &tls.Config{ServerName: s.smtpHost})
+	if err != nil {
+		return fmt.Errorf("failed to dial SMTP server: %w", err)
+	}
+	defer conn.Close()
+
+	client, err := smtp.NewClient(conn, s.smtpHost)
+	if err != nil {
+		return fmt.Errorf("failed to create SMTP client: %w", err)
+	}
+	defer client.Close()
+
+	if err = client.Auth(auth); err != nil {
+		return fmt.Errorf("SMTP authentication failed: %w", err)
+	}
+
+	if err = client.Mail(s.from); err != nil {
+		return fmt.Errorf("failed to set sender: %w", err)
+	}
+
+	for _, addr := range strings.Split(to, ",") {
+		if err = client.Rcpt(strings.TrimSpace(addr)); err != nil {
+			return fmt.Errorf("failed to set recipient %s: %w", addr, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to get data writer: %w", err)
+	}
+
+	if _, err = w.Write([]byte(msg)); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("failed to close writer: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// MockSender for testing
+type MockSender struct{}
+
+func (m *MockSender) SendEmail(to, subject, body string) error {
+	fmt.Printf("Mock email sent to: %s\nSubject: %s\nBody: %s\n", to, subject, body)
+	return nil
+}
+
+func (m *MockSender) SendMultipartEmail(to, subject, text, html string) error {
+	fmt.Printf("Mock email sent to: %s\nSubject: %s\nText: %s\nHTML: %s\n", to, subject, text, html)
+	return nil
+}
@@ -0,0 +1,128 @@
+package messaging
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Conn is the part of a NATS connection the publisher uses
+type Conn interface {
+	Publish(subject string, data []byte) error
+}
+
+// PublisherStats counts publish outcomes since the publisher was created
+type PublisherStats struct {
+	Published uint64 // messages delivered to the connection
+	Failures  uint64 // failed attempts, including those retried later
+	Retries   uint64
+	Dropped   uint64 // messages given up on, or refused because the queue was full
+}
+
+type message struct {
+	subject string
+	data    []byte
+}
+
+// Publisher sends protobuf messages to NATS from a queue, so callers never
+// block on the connection. Failed publishes are retried with exponential
+// backoff, in order, until maxAttempts is reached.
+type Publisher struct {
+	conn        Conn
+	queue       chan message
+	maxAttempts int
+	backoff     time.Duration
+
+	published atomic.Uint64
+	failures  atomic.Uint64
+	retries   atomic.Uint64
+	dropped   atomic.Uint64
+}
+
+// maxBackoff caps the wait between two attempts
+const maxBackoff = 30 * time.Second
+
+func NewPublisher(conn Conn, queueSize, maxAttempts int, backoff time.Duration) *Publisher {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return &Publisher{
+		conn:        conn,
+		queue:       make(chan message, queueSize),
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+	}
+}
+
+// Publish queues msg for subject. A message that cannot be encoded or
+// queued is dropped.
+func (p *Publisher) Publish(subject string, msg proto.Message) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		p.dropped.Add(1)
+		log.Printf("Failed to encode %s message: %v", subject, err)
+		return
+	}
+
+	select {
+	case p.queue <- message{subject: subject, data: data}:
+	default:
+		p.dropped.Add(1)
+		log.Printf("Dropped %s message: publish queue is full", subject)
+	}
+}
+
+// Run publishes queued messages until ctx is done
+func (p *Publisher) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			if n := len(p.queue); n > 0 {
+				log.Printf("Publisher stopped with %d messages queued", n)
+			}
+			return
+		case m := <-p.queue:
+			p.send(ctx, m)
+		}
+	}
+}
+
+func (p *Publisher) send(ctx context.Context, m message) {
+	wait := p.backoff
+	for attempt := 1; ; attempt++ {
+		err := p.conn.Publish(m.subject, m.data)
+		if err == nil {
+			p.published.Add(1)
+			return
+		}
+		p.failures.Add(1)
+
+		if attempt == p.maxAttempts {
+			p.dropped.Add(1)
+			log.Printf("Dropped %s message after %d attempts: %v", m.subject, attempt, err)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			p.dropped.Add(1)
+			return
+		case <-time.After(wait):
+		}
+		p.retries.Add(1)
+		wait = min(wait*2, maxBackoff)
+	}
+}
+
+// Stats returns the publish counts
+func (p *Publisher) Stats() PublisherStats {
+	return PublisherStats{
+		Published: p.published.Load(),
+		Failures:  p.failures.Load(),
+		Retries:   p.retries.Load(),
+		Dropped:   p.dropped.Load(),
+	}
+}
@@ -0,0 +1,167 @@
+package metrics
+
+import (
+	"context"
+	"cs2-marketplace-microservices/transaction-service/internal/cache"
+	"cs2-marketplace-microservices/transaction-service/pkg/messaging"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// gRPC request metrics
+	RequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "transaction_service_requests_total",
+			Help: "Total number of requests processed by transaction service",
+		},
+		[]string{"method", "status"},
+	)
+
+	RequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "transaction_service_request_duration_seconds",
+			Help:    "Request duration in seconds",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+
+	// Business logic metrics
+	TransactionsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "transaction_service_transactions_total",
+			Help: "Total number of transactions that entered each status",
+		},
+		[]string{"status", "type"},
+	)
+
+	TransactionAmount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "transaction_service_transaction_amount_total",
+			Help: "Total amount of transactions that entered each status, in major units",
+		},
+		[]string{"status", "type", "currency"},
+	)
+
+	DatabaseConnections = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "transaction_service_db_connections_active",
+			Help: "Number of active database connections",
+		},
+	)
+
+	// Error metrics
+	ErrorsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "transaction_service_errors_total",
+			Help: "Total number of errors in transaction service",
+		},
+		[]string{"type", "method"},
+	)
+
+	// Service health
+	ServiceUp = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "transaction_service_up",
+			Help: "Whether the transaction service is up (1) or down (0)",
+		},
+	)
+)
+
+func init() {
+	// Set service as up when metrics package is initialized
+	ServiceUp.Set(1)
+}
+
+// RegisterCache exports the lookup counts and hit ratio of the transaction cache
+func RegisterCache(c *cache.Cache) {
+	promauto.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "transaction_service_cache_hit_ratio",
+			Help: "Share of cache lookups that were hits",
+		},
+		func() float64 { return c.Stats().HitRatio() },
+	)
+	promauto.NewCounterFunc(
+		prometheus.CounterOpts{
+			Name: "transaction_service_cache_hits_total",
+			Help: "Total number of cache lookups that were hits",
+		},
+		func() float64 { return float64(c.Stats().Hits) },
+	)
+	promauto.NewCounterFunc(
+		prometheus.CounterOpts{
+			Name: "transaction_service_cache_misses_total",
+			Help: "Total number of cache lookups that were misses",
+		},
+		func() float64 { return float64(c.Stats().Misses) },
+	)
+	promauto.NewCounterFunc(
+		prometheus.CounterOpts{
+			Name: "transaction_service_cache_evictions_total",
+			Help: "Total number of cache entries removed by expiry, deletion or invalidation",
+		},
+		func() float64 { return float64(c.Stats().Evictions) },
+	)
+}
+
+// RegisterPublisher exports the outcomes of NATS event publishing
+func RegisterPublisher(p *messaging.Publisher) {
+	counters := []struct {
+		name, help string
+		value      func(messaging.PublisherStats) uint64
+	}{
+		{"transaction_service_messages_published_total", "Total number of messages published to NATS",
+			func(s messaging.PublisherStats) uint64 { return s.Published }},
+		{"transaction_service_message_publish_failures_total", "Total number of failed attempts to publish a message to NATS",
+			func(s messaging.PublisherStats) uint64 { return s.Failures }},
+		{"transaction_service_message_publish_retries_total", "Total number of retried attempts to publish a message to NATS",
+			func(s messaging.PublisherStats) uint64 { return s.Retries }},
+		{"transaction_service_messages_dropped_total", "Total number of messages given up on without being published",
+			func(s messaging.PublisherStats) uint64 { return s.Dropped }},
+	}
+	for _, c := range counters {
+		value := c.value
+		promauto.NewCounterFunc(
+			prometheus.CounterOpts{Name: c.name, Help: c.help},
+			func() float64 { return float64(value(p.Stats())) },
+		)
+	}
+}
+
+// RecordTransaction counts a transaction entering its current status
+func RecordTransaction(status, txType string, amount float64, currency string) {
+	TransactionsTotal.WithLabelValues(status, txType).Inc()
+	TransactionAmount.WithLabelValues(status, txType, currency).Add(amount)
+}
+
+// observe records a finished request under its full gRPC method name
+func observe(method string, start time.Time, err error) {
+	code := status.Code(err).String()
+	RequestsTotal.WithLabelValues(method, code).Inc()
+	RequestDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		ErrorsTotal.WithLabelValues(code, method).Inc()
+	}
+}
+
+// UnaryServerInterceptor records the count, status and duration of unary requests
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observe(info.FullMethod, start, err)
+	return resp, err
+}
+
+// StreamServerInterceptor records the count, status and duration of streams
+func StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	observe(info.FullMethod, start, err)
+	return err
+}
@@ -7,6 +7,7 @@
 package transaction
 
 import (
+	money "cs2-marketplace-microservices/shared/proto/money"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -76,8 +77,10 @@ func (TransactionStatus) EnumDescriptor() ([]byte, []int) {
 type TransactionType int32
 
 const (
-	TransactionType_BUY  TransactionType = 0
-	TransactionType_SELL TransactionType = 1
+	TransactionType_BUY    TransactionType = 0
+	TransactionType_SELL   TransactionType = 1
+	TransactionType_REFUND TransactionType = 2 // returns a completed transaction's amount from the seller to the buyer
+	TransactionType_TRADE  TransactionType = 3 // swaps skins between buyer and seller; the buyer may add a balance top-up as the amount
 )
 
 // Enum value maps for TransactionType.
@@ -85,10 +88,14 @@ var (
 	TransactionType_name = map[int32]string{
 		0: "BUY",
 		1: "SELL",
+		2: "REFUND",
+		3: "TRADE",
 	}
 	TransactionType_value = map[string]int32{
-		"BUY":  0,
-		"SELL": 1,
+		"BUY":    0,
+		"SELL":   1,
+		"REFUND": 2,
+		"TRADE":  3,
 	}
 )
 
@@ -119,19 +126,486 @@ func (TransactionType) EnumDescriptor() ([]byte, []int) {
 	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{1}
 }
 
+type DisputeStatus int32
+
+const (
+	DisputeStatus_DISPUTE_OPEN     DisputeStatus = 0
+	DisputeStatus_DISPUTE_REFUNDED DisputeStatus = 1 // resolved in the buyer's favor
+	DisputeStatus_DISPUTE_REJECTED DisputeStatus = 2 // resolved in the seller's favor
+)
+
+// Enum value maps for DisputeStatus.
+var (
+	DisputeStatus_name = map[int32]string{
+		0: "DISPUTE_OPEN",
+		1: "DISPUTE_REFUNDED",
+		2: "DISPUTE_REJECTED",
+	}
+	DisputeStatus_value = map[string]int32{
+		"DISPUTE_OPEN":     0,
+		"DISPUTE_REFUNDED": 1,
+		"DISPUTE_REJECTED": 2,
+	}
+)
+
+func (x DisputeStatus) Enum() *DisputeStatus {
+	p := new(DisputeStatus)
+	*p = x
+	return p
+}
+
+func (x DisputeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DisputeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_transaction_proto_enumTypes[2].Descriptor()
+}
+
+func (DisputeStatus) Type() protoreflect.EnumType {
+	return &file_shared_proto_transaction_proto_enumTypes[2]
+}
+
+func (x DisputeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DisputeStatus.Descriptor instead.
+func (DisputeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{2}
+}
+
+type RiskDecision int32
+
+const (
+	RiskDecision_RISK_ALLOW  RiskDecision = 0
+	RiskDecision_RISK_REVIEW RiskDecision = 1 // allowed, but flagged for a manual review
+	RiskDecision_RISK_BLOCK  RiskDecision = 2
+)
+
+// Enum value maps for RiskDecision.
+var (
+	RiskDecision_name = map[int32]string{
+		0: "RISK_ALLOW",
+		1: "RISK_REVIEW",
+		2: "RISK_BLOCK",
+	}
+	RiskDecision_value = map[string]int32{
+		"RISK_ALLOW":  0,
+		"RISK_REVIEW": 1,
+		"RISK_BLOCK":  2,
+	}
+)
+
+func (x RiskDecision) Enum() *RiskDecision {
+	p := new(RiskDecision)
+	*p = x
+	return p
+}
+
+func (x RiskDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RiskDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_transaction_proto_enumTypes[3].Descriptor()
+}
+
+func (RiskDecision) Type() protoreflect.EnumType {
+	return &file_shared_proto_transaction_proto_enumTypes[3]
+}
+
+func (x RiskDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RiskDecision.Descriptor instead.
+func (RiskDecision) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{3}
+}
+
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_PENDING   OrderStatus = 0
+	OrderStatus_ORDER_COMPLETED OrderStatus = 1 // every item was bought
+	OrderStatus_ORDER_FAILED    OrderStatus = 2 // nothing was bought
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "ORDER_PENDING",
+		1: "ORDER_COMPLETED",
+		2: "ORDER_FAILED",
+	}
+	OrderStatus_value = map[string]int32{
+		"ORDER_PENDING":   0,
+		"ORDER_COMPLETED": 1,
+		"ORDER_FAILED":    2,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_transaction_proto_enumTypes[4].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_shared_proto_transaction_proto_enumTypes[4]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{4}
+}
+
+type TransactionEventType int32
+
+const (
+	TransactionEventType_TRANSACTION_CREATED        TransactionEventType = 0
+	TransactionEventType_TRANSACTION_UPDATED        TransactionEventType = 1
+	TransactionEventType_TRANSACTION_STATUS_CHANGED TransactionEventType = 2
+	TransactionEventType_TRANSACTION_DELETED        TransactionEventType = 3
+)
+
+// Enum value maps for TransactionEventType.
+var (
+	TransactionEventType_name = map[int32]string{
+		0: "TRANSACTION_CREATED",
+		1: "TRANSACTION_UPDATED",
+		2: "TRANSACTION_STATUS_CHANGED",
+		3: "TRANSACTION_DELETED",
+	}
+	TransactionEventType_value = map[string]int32{
+		"TRANSACTION_CREATED":        0,
+		"TRANSACTION_UPDATED":        1,
+		"TRANSACTION_STATUS_CHANGED": 2,
+		"TRANSACTION_DELETED":        3,
+	}
+)
+
+func (x TransactionEventType) Enum() *TransactionEventType {
+	p := new(TransactionEventType)
+	*p = x
+	return p
+}
+
+func (x TransactionEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_transaction_proto_enumTypes[5].Descriptor()
+}
+
+func (TransactionEventType) Type() protoreflect.EnumType {
+	return &file_shared_proto_transaction_proto_enumTypes[5]
+}
+
+func (x TransactionEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionEventType.Descriptor instead.
+func (TransactionEventType) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{5}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_CSV        ExportFormat = 0
+	ExportFormat_JSON_LINES ExportFormat = 1
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "CSV",
+		1: "JSON_LINES",
+	}
+	ExportFormat_value = map[string]int32{
+		"CSV":        0,
+		"JSON_LINES": 1,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_transaction_proto_enumTypes[6].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_shared_proto_transaction_proto_enumTypes[6]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{6}
+}
+
+// StatsDimension is a field transaction statistics can be grouped by
+type StatsDimension int32
+
+const (
+	StatsDimension_STATS_BY_TYPE   StatsDimension = 0
+	StatsDimension_STATS_BY_STATUS StatsDimension = 1
+	StatsDimension_STATS_BY_ROLE   StatsDimension = 2 // buyer or seller; requires a user_id
+	StatsDimension_STATS_BY_RARITY StatsDimension = 3 // of the skin, looked up from inventory-service
+)
+
+// Enum value maps for StatsDimension.
+var (
+	StatsDimension_name = map[int32]string{
+		0: "STATS_BY_TYPE",
+		1: "STATS_BY_STATUS",
+		2: "STATS_BY_ROLE",
+		3: "STATS_BY_RARITY",
+	}
+	StatsDimension_value = map[string]int32{
+		"STATS_BY_TYPE":   0,
+		"STATS_BY_STATUS": 1,
+		"STATS_BY_ROLE":   2,
+		"STATS_BY_RARITY": 3,
+	}
+)
+
+func (x StatsDimension) Enum() *StatsDimension {
+	p := new(StatsDimension)
+	*p = x
+	return p
+}
+
+func (x StatsDimension) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsDimension) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_transaction_proto_enumTypes[7].Descriptor()
+}
+
+func (StatsDimension) Type() protoreflect.EnumType {
+	return &file_shared_proto_transaction_proto_enumTypes[7]
+}
+
+func (x StatsDimension) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsDimension.Descriptor instead.
+func (StatsDimension) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{7}
+}
+
+type LeaderboardMetric int32
+
+const (
+	LeaderboardMetric_LEADERBOARD_VOLUME       LeaderboardMetric = 0 // amount bought and sold
+	LeaderboardMetric_LEADERBOARD_TRANSACTIONS LeaderboardMetric = 1 // number of purchases and sales
+	LeaderboardMetric_LEADERBOARD_PROFIT       LeaderboardMetric = 2 // seller proceeds and refunds received minus amount spent
+)
+
+// Enum value maps for LeaderboardMetric.
+var (
+	LeaderboardMetric_name = map[int32]string{
+		0: "LEADERBOARD_VOLUME",
+		1: "LEADERBOARD_TRANSACTIONS",
+		2: "LEADERBOARD_PROFIT",
+	}
+	LeaderboardMetric_value = map[string]int32{
+		"LEADERBOARD_VOLUME":       0,
+		"LEADERBOARD_TRANSACTIONS": 1,
+		"LEADERBOARD_PROFIT":       2,
+	}
+)
+
+func (x LeaderboardMetric) Enum() *LeaderboardMetric {
+	p := new(LeaderboardMetric)
+	*p = x
+	return p
+}
+
+func (x LeaderboardMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_transaction_proto_enumTypes[8].Descriptor()
+}
+
+func (LeaderboardMetric) Type() protoreflect.EnumType {
+	return &file_shared_proto_transaction_proto_enumTypes[8]
+}
+
+func (x LeaderboardMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardMetric.Descriptor instead.
+func (LeaderboardMetric) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{8}
+}
+
+type LeaderboardWindow int32
+
+const (
+	LeaderboardWindow_WINDOW_DAY      LeaderboardWindow = 0 // the last 24 hours
+	LeaderboardWindow_WINDOW_WEEK     LeaderboardWindow = 1 // the last 7 days
+	LeaderboardWindow_WINDOW_MONTH    LeaderboardWindow = 2 // the last 30 days
+	LeaderboardWindow_WINDOW_ALL_TIME LeaderboardWindow = 3
+)
+
+// Enum value maps for LeaderboardWindow.
+var (
+	LeaderboardWindow_name = map[int32]string{
+		0: "WINDOW_DAY",
+		1: "WINDOW_WEEK",
+		2: "WINDOW_MONTH",
+		3: "WINDOW_ALL_TIME",
+	}
+	LeaderboardWindow_value = map[string]int32{
+		"WINDOW_DAY":      0,
+		"WINDOW_WEEK":     1,
+		"WINDOW_MONTH":    2,
+		"WINDOW_ALL_TIME": 3,
+	}
+)
+
+func (x LeaderboardWindow) Enum() *LeaderboardWindow {
+	p := new(LeaderboardWindow)
+	*p = x
+	return p
+}
+
+func (x LeaderboardWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_transaction_proto_enumTypes[9].Descriptor()
+}
+
+func (LeaderboardWindow) Type() protoreflect.EnumType {
+	return &file_shared_proto_transaction_proto_enumTypes[9]
+}
+
+func (x LeaderboardWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardWindow.Descriptor instead.
+func (LeaderboardWindow) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{9}
+}
+
+type BucketInterval int32
+
+const (
+	BucketInterval_DAY   BucketInterval = 0
+	BucketInterval_HOUR  BucketInterval = 1
+	BucketInterval_WEEK  BucketInterval = 2 // weeks start on Monday
+	BucketInterval_MONTH BucketInterval = 3
+)
+
+// Enum value maps for BucketInterval.
+var (
+	BucketInterval_name = map[int32]string{
+		0: "DAY",
+		1: "HOUR",
+		2: "WEEK",
+		3: "MONTH",
+	}
+	BucketInterval_value = map[string]int32{
+		"DAY":   0,
+		"HOUR":  1,
+		"WEEK":  2,
+		"MONTH": 3,
+	}
+)
+
+func (x BucketInterval) Enum() *BucketInterval {
+	p := new(BucketInterval)
+	*p = x
+	return p
+}
+
+func (x BucketInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BucketInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_transaction_proto_enumTypes[10].Descriptor()
+}
+
+func (BucketInterval) Type() protoreflect.EnumType {
+	return &file_shared_proto_transaction_proto_enumTypes[10]
+}
+
+func (x BucketInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BucketInterval.Descriptor instead.
+func (BucketInterval) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{10}
+}
+
 type Transaction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	BuyerId       string                 `protobuf:"bytes,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
-	SellerId      string                 `protobuf:"bytes,3,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
-	SkinId        string                 `protobuf:"bytes,4,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Date          string                 `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
-	Status        TransactionStatus      `protobuf:"varint,7,opt,name=status,proto3,enum=transaction.TransactionStatus" json:"status,omitempty"`
-	Type          TransactionType        `protobuf:"varint,8,opt,name=type,proto3,enum=transaction.TransactionType" json:"type,omitempty"`
-	Description   string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BuyerId             string                 `protobuf:"bytes,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	SellerId            string                 `protobuf:"bytes,3,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	SkinId              string                 `protobuf:"bytes,4,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	Date                string                 `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	Status              TransactionStatus      `protobuf:"varint,7,opt,name=status,proto3,enum=transaction.TransactionStatus" json:"status,omitempty"`
+	Type                TransactionType        `protobuf:"varint,8,opt,name=type,proto3,enum=transaction.TransactionType" json:"type,omitempty"`
+	Description         string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	Version             int64                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	HoldId              string                 `protobuf:"bytes,11,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`                                          // hold on the buyer's funds while the transaction is pending
+	RefundOfId          string                 `protobuf:"bytes,14,opt,name=refund_of_id,json=refundOfId,proto3" json:"refund_of_id,omitempty"`                            // set on refunds - the refunded transaction
+	RefundTransactionId string                 `protobuf:"bytes,15,opt,name=refund_transaction_id,json=refundTransactionId,proto3" json:"refund_transaction_id,omitempty"` // set on refunded transactions - the refund
+	DisputeId           string                 `protobuf:"bytes,16,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`                                 // set on refunds - the dispute that caused the refund
+	DeletedAt           string                 `protobuf:"bytes,17,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`                                 // RFC3339, set when the transaction was deleted
+	DeletedBy           string                 `protobuf:"bytes,18,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	Archived            bool                   `protobuf:"varint,19,opt,name=archived,proto3" json:"archived,omitempty"` // moved to the archive, read-only
+	Amount              *money.Money           `protobuf:"bytes,20,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeAmount           *money.Money           `protobuf:"bytes,21,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	SellerProceeds      *money.Money           `protobuf:"bytes,22,opt,name=seller_proceeds,json=sellerProceeds,proto3" json:"seller_proceeds,omitempty"`
+	Conversions         []*money.Conversion    `protobuf:"bytes,23,rep,name=conversions,proto3" json:"conversions,omitempty"`                            // exchange rates used to settle with balances in other currencies
+	BuyerSkinIds        []string               `protobuf:"bytes,24,rep,name=buyer_skin_ids,json=buyerSkinIds,proto3" json:"buyer_skin_ids,omitempty"`    // set on trades - skins the buyer gives to the seller
+	SellerSkinIds       []string               `protobuf:"bytes,25,rep,name=seller_skin_ids,json=sellerSkinIds,proto3" json:"seller_skin_ids,omitempty"` // set on trades - skins the seller gives to the buyer
+	ProposerId          string                 `protobuf:"bytes,26,opt,name=proposer_id,json=proposerId,proto3" json:"proposer_id,omitempty"`            // set on trades - the side that offered the trade
+	OrderId             string                 `protobuf:"bytes,27,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                     // set on cart purchases - the parent order
+	Risk                *RiskAssessment        `protobuf:"bytes,28,opt,name=risk,proto3" json:"risk,omitempty"`                                          // outcome of the risk checks run before the transaction was created
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Transaction) Reset() {
@@ -141,14 +615,3330 @@ func (x *Transaction) Reset() {
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) String() string {
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Transaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Transaction) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *Transaction) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *Transaction) GetSkinId() string {
+	if x != nil {
+		return x.SkinId
+	}
+	return ""
+}
+
+func (x *Transaction) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Transaction) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_PENDING
+}
+
+func (x *Transaction) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_BUY
+}
+
+func (x *Transaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Transaction) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Transaction) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *Transaction) GetRefundOfId() string {
+	if x != nil {
+		return x.RefundOfId
+	}
+	return ""
+}
+
+func (x *Transaction) GetRefundTransactionId() string {
+	if x != nil {
+		return x.RefundTransactionId
+	}
+	return ""
+}
+
+func (x *Transaction) GetDisputeId() string {
+	if x != nil {
+		return x.DisputeId
+	}
+	return ""
+}
+
+func (x *Transaction) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Transaction) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+func (x *Transaction) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Transaction) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Transaction) GetFeeAmount() *money.Money {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return nil
+}
+
+func (x *Transaction) GetSellerProceeds() *money.Money {
+	if x != nil {
+		return x.SellerProceeds
+	}
+	return nil
+}
+
+func (x *Transaction) GetConversions() []*money.Conversion {
+	if x != nil {
+		return x.Conversions
+	}
+	return nil
+}
+
+func (x *Transaction) GetBuyerSkinIds() []string {
+	if x != nil {
+		return x.BuyerSkinIds
+	}
+	return nil
+}
+
+func (x *Transaction) GetSellerSkinIds() []string {
+	if x != nil {
+		return x.SellerSkinIds
+	}
+	return nil
+}
+
+func (x *Transaction) GetProposerId() string {
+	if x != nil {
+		return x.ProposerId
+	}
+	return ""
+}
+
+func (x *Transaction) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Transaction) GetRisk() *RiskAssessment {
+	if x != nil {
+		return x.Risk
+	}
+	return nil
+}
+
+// RiskAssessment is the combined decision of the risk rules and every rule that triggered
+type RiskAssessment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decision      RiskDecision           `protobuf:"varint,1,opt,name=decision,proto3,enum=transaction.RiskDecision" json:"decision,omitempty"`
+	Findings      []*RiskFinding         `protobuf:"bytes,2,rep,name=findings,proto3" json:"findings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiskAssessment) Reset() {
+	*x = RiskAssessment{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskAssessment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskAssessment) ProtoMessage() {}
+
+func (x *RiskAssessment) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskAssessment.ProtoReflect.Descriptor instead.
+func (*RiskAssessment) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RiskAssessment) GetDecision() RiskDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return RiskDecision_RISK_ALLOW
+}
+
+func (x *RiskAssessment) GetFindings() []*RiskFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+type RiskFinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Decision      RiskDecision           `protobuf:"varint,2,opt,name=decision,proto3,enum=transaction.RiskDecision" json:"decision,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiskFinding) Reset() {
+	*x = RiskFinding{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskFinding) ProtoMessage() {}
+
+func (x *RiskFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskFinding.ProtoReflect.Descriptor instead.
+func (*RiskFinding) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RiskFinding) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *RiskFinding) GetDecision() RiskDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return RiskDecision_RISK_ALLOW
+}
+
+func (x *RiskFinding) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// StatusChange is one entry of a transaction's append-only history
+type StatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromStatus    *TransactionStatus     `protobuf:"varint,1,opt,name=from_status,json=fromStatus,proto3,enum=transaction.TransactionStatus,oneof" json:"from_status,omitempty"` // unset for the creation entry
+	ToStatus      TransactionStatus      `protobuf:"varint,2,opt,name=to_status,json=toStatus,proto3,enum=transaction.TransactionStatus" json:"to_status,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // user id, or "system" for automatic changes
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChange) Reset() {
+	*x = StatusChange{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChange) ProtoMessage() {}
+
+func (x *StatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StatusChange) GetFromStatus() TransactionStatus {
+	if x != nil && x.FromStatus != nil {
+		return *x.FromStatus
+	}
+	return TransactionStatus_PENDING
+}
+
+func (x *StatusChange) GetToStatus() TransactionStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return TransactionStatus_PENDING
+}
+
+func (x *StatusChange) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *StatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StatusChange) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// Order is the parent of the transactions bought together in one cart purchase
+type Order struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BuyerId       string                 `protobuf:"bytes,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=transaction.OrderStatus" json:"status,omitempty"`
+	Total         *money.Money           `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	Items         []*OrderItem           `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	FailureReason string                 `protobuf:"bytes,6,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"` // set on failed orders
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Order) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Order) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *Order) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_PENDING
+}
+
+func (x *Order) GetTotal() *money.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *Order) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Order) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Order) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// OrderItem is one skin of an order and what happened to it
+type OrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkinId        string                 `protobuf:"bytes,1,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	SellerId      string                 `protobuf:"bytes,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	Price         *money.Money           `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	TransactionId string                 `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"` // the child transaction, once created
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`                                      // why this item failed or was not bought
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OrderItem) GetSkinId() string {
+	if x != nil {
+		return x.SkinId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *OrderItem) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Receipt of a completed purchase
+type Receipt struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Number         string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"` // sequential, e.g. "R-00000042"
+	TransactionId  string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	BuyerId        string                 `protobuf:"bytes,3,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	BuyerUsername  string                 `protobuf:"bytes,4,opt,name=buyer_username,json=buyerUsername,proto3" json:"buyer_username,omitempty"`
+	SellerId       string                 `protobuf:"bytes,5,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	SellerUsername string                 `protobuf:"bytes,6,opt,name=seller_username,json=sellerUsername,proto3" json:"seller_username,omitempty"`
+	Items          []*ReceiptItem         `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`
+	Subtotal       *money.Money           `protobuf:"bytes,8,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	FeeAmount      *money.Money           `protobuf:"bytes,9,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"` // marketplace fee, withheld from the seller
+	SellerProceeds *money.Money           `protobuf:"bytes,10,opt,name=seller_proceeds,json=sellerProceeds,proto3" json:"seller_proceeds,omitempty"`
+	Total          *money.Money           `protobuf:"bytes,11,opt,name=total,proto3" json:"total,omitempty"`                       // paid by the buyer
+	IssuedAt       string                 `protobuf:"bytes,12,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"` // RFC3339
+	Html           string                 `protobuf:"bytes,13,opt,name=html,proto3" json:"html,omitempty"`
+	Text           string                 `protobuf:"bytes,14,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Receipt) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Receipt) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Receipt) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *Receipt) GetBuyerUsername() string {
+	if x != nil {
+		return x.BuyerUsername
+	}
+	return ""
+}
+
+func (x *Receipt) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *Receipt) GetSellerUsername() string {
+	if x != nil {
+		return x.SellerUsername
+	}
+	return ""
+}
+
+func (x *Receipt) GetItems() []*ReceiptItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Receipt) GetSubtotal() *money.Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *Receipt) GetFeeAmount() *money.Money {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return nil
+}
+
+func (x *Receipt) GetSellerProceeds() *money.Money {
+	if x != nil {
+		return x.SellerProceeds
+	}
+	return nil
+}
+
+func (x *Receipt) GetTotal() *money.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *Receipt) GetIssuedAt() string {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return ""
+}
+
+func (x *Receipt) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+func (x *Receipt) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ReceiptItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkinId        string                 `protobuf:"bytes,1,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Condition     string                 `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	Rarity        string                 `protobuf:"bytes,4,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Price         *money.Money           `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiptItem) Reset() {
+	*x = ReceiptItem{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptItem) ProtoMessage() {}
+
+func (x *ReceiptItem) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptItem.ProtoReflect.Descriptor instead.
+func (*ReceiptItem) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReceiptItem) GetSkinId() string {
+	if x != nil {
+		return x.SkinId
+	}
+	return ""
+}
+
+func (x *ReceiptItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReceiptItem) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *ReceiptItem) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *ReceiptItem) GetPrice() *money.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type Dispute struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId       string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	BuyerId             string                 `protobuf:"bytes,3,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	SellerId            string                 `protobuf:"bytes,4,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	Reason              string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Evidence            string                 `protobuf:"bytes,6,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	Status              DisputeStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=transaction.DisputeStatus" json:"status,omitempty"`
+	ResolvedBy          string                 `protobuf:"bytes,8,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	ResolutionNote      string                 `protobuf:"bytes,9,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty"`
+	ReturnItem          bool                   `protobuf:"varint,10,opt,name=return_item,json=returnItem,proto3" json:"return_item,omitempty"`
+	RefundTransactionId string                 `protobuf:"bytes,11,opt,name=refund_transaction_id,json=refundTransactionId,proto3" json:"refund_transaction_id,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt          string                 `protobuf:"bytes,13,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Dispute) Reset() {
+	*x = Dispute{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dispute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dispute) ProtoMessage() {}
+
+func (x *Dispute) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dispute.ProtoReflect.Descriptor instead.
+func (*Dispute) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Dispute) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Dispute) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Dispute) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *Dispute) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *Dispute) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Dispute) GetEvidence() string {
+	if x != nil {
+		return x.Evidence
+	}
+	return ""
+}
+
+func (x *Dispute) GetStatus() DisputeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DisputeStatus_DISPUTE_OPEN
+}
+
+func (x *Dispute) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *Dispute) GetResolutionNote() string {
+	if x != nil {
+		return x.ResolutionNote
+	}
+	return ""
+}
+
+func (x *Dispute) GetReturnItem() bool {
+	if x != nil {
+		return x.ReturnItem
+	}
+	return false
+}
+
+func (x *Dispute) GetRefundTransactionId() string {
+	if x != nil {
+		return x.RefundTransactionId
+	}
+	return ""
+}
+
+func (x *Dispute) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Dispute) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+// Request messages
+type CreateTransactionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BuyerId        string                 `protobuf:"bytes,1,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	SellerId       string                 `protobuf:"bytes,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	SkinId         string                 `protobuf:"bytes,3,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	Type           TransactionType        `protobuf:"varint,5,opt,name=type,proto3,enum=transaction.TransactionType" json:"type,omitempty"`
+	Description    string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // optional - retries with the same key for the same buyer return the original transaction
+	Amount         *money.Money           `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Conversions    []*money.Conversion    `protobuf:"bytes,9,rep,name=conversions,proto3" json:"conversions,omitempty"` // required if a balance or the fee schedule is in another currency than amount
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateTransactionRequest) Reset() {
+	*x = CreateTransactionRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransactionRequest) ProtoMessage() {}
+
+func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateTransactionRequest) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetSkinId() string {
+	if x != nil {
+		return x.SkinId
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_BUY
+}
+
+func (x *CreateTransactionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateTransactionRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CreateTransactionRequest) GetConversions() []*money.Conversion {
+	if x != nil {
+		return x.Conversions
+	}
+	return nil
+}
+
+// CreateTradeRequest offers a trade. The other side accepts it with AcceptTrade
+// and either side declines it with CancelTransaction.
+type CreateTradeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProposerId     string                 `protobuf:"bytes,1,opt,name=proposer_id,json=proposerId,proto3" json:"proposer_id,omitempty"` // must be buyer_id or seller_id
+	BuyerId        string                 `protobuf:"bytes,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`          // the side paying the top-up, if any
+	SellerId       string                 `protobuf:"bytes,3,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	BuyerSkinIds   []string               `protobuf:"bytes,4,rep,name=buyer_skin_ids,json=buyerSkinIds,proto3" json:"buyer_skin_ids,omitempty"`    // skins the buyer gives to the seller
+	SellerSkinIds  []string               `protobuf:"bytes,5,rep,name=seller_skin_ids,json=sellerSkinIds,proto3" json:"seller_skin_ids,omitempty"` // skins the seller gives to the buyer
+	TopUp          *money.Money           `protobuf:"bytes,6,opt,name=top_up,json=topUp,proto3" json:"top_up,omitempty"`                           // optional - balance the buyer adds, held until the trade completes
+	Conversions    []*money.Conversion    `protobuf:"bytes,7,rep,name=conversions,proto3" json:"conversions,omitempty"`
+	Description    string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,9,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // optional - retries with the same key for the same buyer return the original trade
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateTradeRequest) Reset() {
+	*x = CreateTradeRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTradeRequest) ProtoMessage() {}
+
+func (x *CreateTradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTradeRequest.ProtoReflect.Descriptor instead.
+func (*CreateTradeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateTradeRequest) GetProposerId() string {
+	if x != nil {
+		return x.ProposerId
+	}
+	return ""
+}
+
+func (x *CreateTradeRequest) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *CreateTradeRequest) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *CreateTradeRequest) GetBuyerSkinIds() []string {
+	if x != nil {
+		return x.BuyerSkinIds
+	}
+	return nil
+}
+
+func (x *CreateTradeRequest) GetSellerSkinIds() []string {
+	if x != nil {
+		return x.SellerSkinIds
+	}
+	return nil
+}
+
+func (x *CreateTradeRequest) GetTopUp() *money.Money {
+	if x != nil {
+		return x.TopUp
+	}
+	return nil
+}
+
+func (x *CreateTradeRequest) GetConversions() []*money.Conversion {
+	if x != nil {
+		return x.Conversions
+	}
+	return nil
+}
+
+func (x *CreateTradeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTradeRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type AcceptTradeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // the side that did not propose the trade
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTradeRequest) Reset() {
+	*x = AcceptTradeRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTradeRequest) ProtoMessage() {}
+
+func (x *AcceptTradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTradeRequest.ProtoReflect.Descriptor instead.
+func (*AcceptTradeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AcceptTradeRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *AcceptTradeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetTransactionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId         string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`                          // optional - user deleting the transaction, for DeleteTransaction
+	IncludeArchived bool                   `protobuf:"varint,3,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // optional - also look in the archive
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTransactionRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *GetTransactionRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type UpdateTransactionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status          *TransactionStatus     `protobuf:"varint,2,opt,name=status,proto3,enum=transaction.TransactionStatus,oneof" json:"status,omitempty"` // optional - unset leaves the status unchanged; purchases, cart orders and trades cannot be set to COMPLETED here
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // optional - if set, the update fails when the version differs
+	ActorId         string                 `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`                          // optional - user making the change, recorded in the history
+	Reason          string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                                           // optional - recorded in the history
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionRequest) Reset() {
+	*x = UpdateTransactionRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionRequest) ProtoMessage() {}
+
+func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetStatus() TransactionStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return TransactionStatus_PENDING
+}
+
+func (x *UpdateTransactionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateTransactionRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetTransactionsByUserRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status          *TransactionStatus     `protobuf:"varint,2,opt,name=status,proto3,enum=transaction.TransactionStatus,oneof" json:"status,omitempty"` // optional filter
+	Type            *TransactionType       `protobuf:"varint,3,opt,name=type,proto3,enum=transaction.TransactionType,oneof" json:"type,omitempty"`       // optional filter
+	Limit           int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                            // optional limit
+	Offset          int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`                                          // optional offset
+	PageToken       string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                    // optional - next_page_token of the previous page, takes precedence over offset
+	IncludeArchived bool                   `protobuf:"varint,7,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // optional - also list archived transactions
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTransactionsByUserRequest) Reset() {
+	*x = GetTransactionsByUserRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsByUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsByUserRequest) ProtoMessage() {}
+
+func (x *GetTransactionsByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsByUserRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionsByUserRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetTransactionsByUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTransactionsByUserRequest) GetStatus() TransactionStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return TransactionStatus_PENDING
+}
+
+func (x *GetTransactionsByUserRequest) GetType() TransactionType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return TransactionType_BUY
+}
+
+func (x *GetTransactionsByUserRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTransactionsByUserRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetTransactionsByUserRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetTransactionsByUserRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type GetTransactionsBySkinRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SkinId          string                 `protobuf:"bytes,1,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	Limit           int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                            // optional - if unset, all transactions are returned
+	PageToken       string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                    // optional - next_page_token of the previous page
+	IncludeArchived bool                   `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // optional - also list archived transactions
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTransactionsBySkinRequest) Reset() {
+	*x = GetTransactionsBySkinRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsBySkinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsBySkinRequest) ProtoMessage() {}
+
+func (x *GetTransactionsBySkinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsBySkinRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionsBySkinRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetTransactionsBySkinRequest) GetSkinId() string {
+	if x != nil {
+		return x.SkinId
+	}
+	return ""
+}
+
+func (x *GetTransactionsBySkinRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTransactionsBySkinRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetTransactionsBySkinRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type GetTransactionsByStatusRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Status          TransactionStatus      `protobuf:"varint,1,opt,name=status,proto3,enum=transaction.TransactionStatus" json:"status,omitempty"`
+	Limit           int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset          int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	PageToken       string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                    // optional - next_page_token of the previous page, takes precedence over offset
+	IncludeArchived bool                   `protobuf:"varint,5,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // optional - also list archived transactions
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTransactionsByStatusRequest) Reset() {
+	*x = GetTransactionsByStatusRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsByStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsByStatusRequest) ProtoMessage() {}
+
+func (x *GetTransactionsByStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsByStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionsByStatusRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetTransactionsByStatusRequest) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_PENDING
+}
+
+func (x *GetTransactionsByStatusRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTransactionsByStatusRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetTransactionsByStatusRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetTransactionsByStatusRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ProcessPurchaseRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BuyerId        string                 `protobuf:"bytes,1,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	SkinId         string                 `protobuf:"bytes,2,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // optional - retries with the same key for the same buyer return the original transaction
+	Conversions    []*money.Conversion    `protobuf:"bytes,4,rep,name=conversions,proto3" json:"conversions,omitempty"`                             // required if a balance or the fee schedule is in another currency than the price
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProcessPurchaseRequest) Reset() {
+	*x = ProcessPurchaseRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessPurchaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessPurchaseRequest) ProtoMessage() {}
+
+func (x *ProcessPurchaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessPurchaseRequest.ProtoReflect.Descriptor instead.
+func (*ProcessPurchaseRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProcessPurchaseRequest) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *ProcessPurchaseRequest) GetSkinId() string {
+	if x != nil {
+		return x.SkinId
+	}
+	return ""
+}
+
+func (x *ProcessPurchaseRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *ProcessPurchaseRequest) GetConversions() []*money.Conversion {
+	if x != nil {
+		return x.Conversions
+	}
+	return nil
+}
+
+type ProcessCartPurchaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuyerId       string                 `protobuf:"bytes,1,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	SkinIds       []string               `protobuf:"bytes,2,rep,name=skin_ids,json=skinIds,proto3" json:"skin_ids,omitempty"`
+	Conversions   []*money.Conversion    `protobuf:"bytes,3,rep,name=conversions,proto3" json:"conversions,omitempty"` // required if a balance, price or the fee schedule is in another currency
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessCartPurchaseRequest) Reset() {
+	*x = ProcessCartPurchaseRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessCartPurchaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessCartPurchaseRequest) ProtoMessage() {}
+
+func (x *ProcessCartPurchaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessCartPurchaseRequest.ProtoReflect.Descriptor instead.
+func (*ProcessCartPurchaseRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ProcessCartPurchaseRequest) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *ProcessCartPurchaseRequest) GetSkinIds() []string {
+	if x != nil {
+		return x.SkinIds
+	}
+	return nil
+}
+
+func (x *ProcessCartPurchaseRequest) GetConversions() []*money.Conversion {
+	if x != nil {
+		return x.Conversions
+	}
+	return nil
+}
+
+type GetReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptRequest) Reset() {
+	*x = GetReceiptRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptRequest) ProtoMessage() {}
+
+func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetReceiptRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type QuoteFeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkinId        string                 `protobuf:"bytes,2,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`       // optional - used to look up the rarity and, without amount, the price
+	Rarity        string                 `protobuf:"bytes,3,opt,name=rarity,proto3" json:"rarity,omitempty"`                     // optional - overrides the rarity of skin_id
+	SellerId      string                 `protobuf:"bytes,4,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"` // optional - used for fee exemptions
+	Amount        *money.Money           `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Conversions   []*money.Conversion    `protobuf:"bytes,6,rep,name=conversions,proto3" json:"conversions,omitempty"` // required if amount is not in the currency of the fee schedule
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteFeesRequest) Reset() {
+	*x = QuoteFeesRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteFeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteFeesRequest) ProtoMessage() {}
+
+func (x *QuoteFeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteFeesRequest.ProtoReflect.Descriptor instead.
+func (*QuoteFeesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *QuoteFeesRequest) GetSkinId() string {
+	if x != nil {
+		return x.SkinId
+	}
+	return ""
+}
+
+func (x *QuoteFeesRequest) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *QuoteFeesRequest) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *QuoteFeesRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *QuoteFeesRequest) GetConversions() []*money.Conversion {
+	if x != nil {
+		return x.Conversions
+	}
+	return nil
+}
+
+type RestoreTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminToken    string                 `protobuf:"bytes,3,opt,name=admin_token,json=adminToken,proto3" json:"admin_token,omitempty"` // session token of an admin
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTransactionRequest) Reset() {
+	*x = RestoreTransactionRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTransactionRequest) ProtoMessage() {}
+
+func (x *RestoreTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTransactionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RestoreTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreTransactionRequest) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+type CancelTransactionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // optional - if set, the cancel fails when the version differs
+	ActorId         string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`                          // optional - user cancelling, recorded in the history
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CancelTransactionRequest) Reset() {
+	*x = CancelTransactionRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTransactionRequest) ProtoMessage() {}
+
+func (x *CancelTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CancelTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CancelTransactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelTransactionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancelTransactionRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *CancelTransactionRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type GetTransactionStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                            // optional - if empty, gets global stats
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                                   // optional
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                         // optional
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`                                                      // optional - defaults to the marketplace currency
+	GroupBy       []StatsDimension       `protobuf:"varint,5,rep,packed,name=group_by,json=groupBy,proto3,enum=transaction.StatsDimension" json:"group_by,omitempty"` // optional - adds a row per combination of the dimensions' values
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionStatsRequest) Reset() {
+	*x = GetTransactionStatsRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionStatsRequest) ProtoMessage() {}
+
+func (x *GetTransactionStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionStatsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTransactionStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTransactionStatsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetTransactionStatsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetTransactionStatsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetTransactionStatsRequest) GetGroupBy() []StatsDimension {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+type GetTransactionVolumeSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interval      BucketInterval         `protobuf:"varint,1,opt,name=interval,proto3,enum=transaction.BucketInterval" json:"interval,omitempty"`
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`                                       // optional - IANA name used for bucket boundaries, defaults to UTC
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                    // optional - RFC3339, inclusive
+	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                          // optional - RFC3339, exclusive
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                             // optional - transactions where the user is buyer or seller
+	SkinId        string                 `protobuf:"bytes,6,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`                             // optional
+	Status        *TransactionStatus     `protobuf:"varint,7,opt,name=status,proto3,enum=transaction.TransactionStatus,oneof" json:"status,omitempty"` // optional
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`                                       // optional - defaults to the marketplace currency
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionVolumeSeriesRequest) Reset() {
+	*x = GetTransactionVolumeSeriesRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionVolumeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionVolumeSeriesRequest) ProtoMessage() {}
+
+func (x *GetTransactionVolumeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionVolumeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionVolumeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetTransactionVolumeSeriesRequest) GetInterval() BucketInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return BucketInterval_DAY
+}
+
+func (x *GetTransactionVolumeSeriesRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetTransactionVolumeSeriesRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GetTransactionVolumeSeriesRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *GetTransactionVolumeSeriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTransactionVolumeSeriesRequest) GetSkinId() string {
+	if x != nil {
+		return x.SkinId
+	}
+	return ""
+}
+
+func (x *GetTransactionVolumeSeriesRequest) GetStatus() TransactionStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return TransactionStatus_PENDING
+}
+
+func (x *GetTransactionVolumeSeriesRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetSkinPriceHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sales of every skin with this name and condition are aggregated
+	SkinName      string         `protobuf:"bytes,1,opt,name=skin_name,json=skinName,proto3" json:"skin_name,omitempty"`
+	Condition     string         `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+	Interval      BucketInterval `protobuf:"varint,3,opt,name=interval,proto3,enum=transaction.BucketInterval" json:"interval,omitempty"`
+	StartTime     string         `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // optional - RFC3339, inclusive
+	EndTime       string         `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // optional - RFC3339, exclusive
+	Currency      string         `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`                    // optional - defaults to the marketplace currency
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkinPriceHistoryRequest) Reset() {
+	*x = GetSkinPriceHistoryRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkinPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkinPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetSkinPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkinPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetSkinPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetSkinPriceHistoryRequest) GetSkinName() string {
+	if x != nil {
+		return x.SkinName
+	}
+	return ""
+}
+
+func (x *GetSkinPriceHistoryRequest) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *GetSkinPriceHistoryRequest) GetInterval() BucketInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return BucketInterval_DAY
+}
+
+func (x *GetSkinPriceHistoryRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *GetSkinPriceHistoryRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *GetSkinPriceHistoryRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metric        LeaderboardMetric      `protobuf:"varint,1,opt,name=metric,proto3,enum=transaction.LeaderboardMetric" json:"metric,omitempty"`
+	Window        LeaderboardWindow      `protobuf:"varint,2,opt,name=window,proto3,enum=transaction.LeaderboardWindow" json:"window,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`      // optional - defaults to 10, at most 100
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"` // optional - defaults to the marketplace currency
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetLeaderboardRequest) GetMetric() LeaderboardMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return LeaderboardMetric_LEADERBOARD_VOLUME
+}
+
+func (x *GetLeaderboardRequest) GetWindow() LeaderboardWindow {
+	if x != nil {
+		return x.Window
+	}
+	return LeaderboardWindow_WINDOW_DAY
+}
+
+func (x *GetLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type WatchTransactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At least one of transaction_id, user_id and skin_id is required
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // transactions where the user is buyer or seller
+	SkinId        string `protobuf:"bytes,3,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
+	ResumeToken   string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // optional - resume_token of the last event received, to replay missed events
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTransactionsRequest) Reset() {
+	*x = WatchTransactionsRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTransactionsRequest) ProtoMessage() {}
+
+func (x *WatchTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*WatchTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WatchTransactionsRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *WatchTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WatchTransactionsRequest) GetSkinId() string {
+	if x != nil {
+		return x.SkinId
+	}
+	return ""
+}
+
+func (x *WatchTransactionsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type ExportTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartTime     string                 `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // optional - RFC3339, inclusive
+	EndTime       string                 `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // optional - RFC3339, exclusive
+	Format        ExportFormat           `protobuf:"varint,4,opt,name=format,proto3,enum=transaction.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTransactionsRequest) Reset() {
+	*x = ExportTransactionsRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTransactionsRequest) ProtoMessage() {}
+
+func (x *ExportTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ExportTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExportTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportTransactionsRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ExportTransactionsRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ExportTransactionsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_CSV
+}
+
+type OpenDisputeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	BuyerId       string                 `protobuf:"bytes,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Evidence      string                 `protobuf:"bytes,4,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenDisputeRequest) Reset() {
+	*x = OpenDisputeRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenDisputeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDisputeRequest) ProtoMessage() {}
+
+func (x *OpenDisputeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDisputeRequest.ProtoReflect.Descriptor instead.
+func (*OpenDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *OpenDisputeRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *OpenDisputeRequest) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *OpenDisputeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OpenDisputeRequest) GetEvidence() string {
+	if x != nil {
+		return x.Evidence
+	}
+	return ""
+}
+
+type GetDisputeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDisputeRequest) Reset() {
+	*x = GetDisputeRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDisputeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDisputeRequest) ProtoMessage() {}
+
+func (x *GetDisputeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDisputeRequest.ProtoReflect.Descriptor instead.
+func (*GetDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetDisputeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDisputesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *DisputeStatus         `protobuf:"varint,2,opt,name=status,proto3,enum=transaction.DisputeStatus,oneof" json:"status,omitempty"` // optional filter
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	AdminToken    string                 `protobuf:"bytes,5,opt,name=admin_token,json=adminToken,proto3" json:"admin_token,omitempty"` // session token of an admin
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDisputesRequest) Reset() {
+	*x = ListDisputesRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDisputesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDisputesRequest) ProtoMessage() {}
+
+func (x *ListDisputesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDisputesRequest.ProtoReflect.Descriptor instead.
+func (*ListDisputesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListDisputesRequest) GetStatus() DisputeStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return DisputeStatus_DISPUTE_OPEN
+}
+
+func (x *ListDisputesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDisputesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListDisputesRequest) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+type ResolveDisputeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminToken    string                 `protobuf:"bytes,6,opt,name=admin_token,json=adminToken,proto3" json:"admin_token,omitempty"`  // session token of an admin
+	Refund        bool                   `protobuf:"varint,3,opt,name=refund,proto3" json:"refund,omitempty"`                           // true resolves in the buyer's favor
+	ReturnItem    bool                   `protobuf:"varint,4,opt,name=return_item,json=returnItem,proto3" json:"return_item,omitempty"` // with refund - also return the skin to the seller
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveDisputeRequest) Reset() {
+	*x = ResolveDisputeRequest{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveDisputeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveDisputeRequest) ProtoMessage() {}
+
+func (x *ResolveDisputeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveDisputeRequest.ProtoReflect.Descriptor instead.
+func (*ResolveDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ResolveDisputeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResolveDisputeRequest) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+func (x *ResolveDisputeRequest) GetRefund() bool {
+	if x != nil {
+		return x.Refund
+	}
+	return false
+}
+
+func (x *ResolveDisputeRequest) GetReturnItem() bool {
+	if x != nil {
+		return x.ReturnItem
+	}
+	return false
+}
+
+func (x *ResolveDisputeRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// Response messages
+type TransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionResponse) Reset() {
+	*x = TransactionResponse{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionResponse) ProtoMessage() {}
+
+func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionResponse.ProtoReflect.Descriptor instead.
+func (*TransactionResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TransactionResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type CartPurchaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Transactions  []*Transaction         `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"` // the child transactions
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartPurchaseResponse) Reset() {
+	*x = CartPurchaseResponse{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartPurchaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartPurchaseResponse) ProtoMessage() {}
+
+func (x *CartPurchaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartPurchaseResponse.ProtoReflect.Descriptor instead.
+func (*CartPurchaseResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CartPurchaseResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *CartPurchaseResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type ReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipt       *Receipt               `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiptResponse) Reset() {
+	*x = ReceiptResponse{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptResponse) ProtoMessage() {}
+
+func (x *ReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptResponse.ProtoReflect.Descriptor instead.
+func (*ReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReceiptResponse) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+type TransactionListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionListResponse) Reset() {
+	*x = TransactionListResponse{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionListResponse) ProtoMessage() {}
+
+func (x *TransactionListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionListResponse.ProtoReflect.Descriptor instead.
+func (*TransactionListResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TransactionListResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *TransactionListResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *TransactionListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResponse) Reset() {
+	*x = DeleteResponse{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResponse) ProtoMessage() {}
+
+func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type TransactionStatsResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	TotalTransactions        int32                  `protobuf:"varint,1,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
+	TotalAmount              float64                `protobuf:"fixed64,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	SuccessfulTransactions   int32                  `protobuf:"varint,3,opt,name=successful_transactions,json=successfulTransactions,proto3" json:"successful_transactions,omitempty"`
+	FailedTransactions       int32                  `protobuf:"varint,4,opt,name=failed_transactions,json=failedTransactions,proto3" json:"failed_transactions,omitempty"` // cancelled transactions are counted separately
+	AverageTransactionAmount float64                `protobuf:"fixed64,5,opt,name=average_transaction_amount,json=averageTransactionAmount,proto3" json:"average_transaction_amount,omitempty"`
+	TotalFeeRevenue          float64                `protobuf:"fixed64,6,opt,name=total_fee_revenue,json=totalFeeRevenue,proto3" json:"total_fee_revenue,omitempty"` // fees collected on completed transactions
+	Currency                 string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`                                          // amounts are in major units of this currency
+	CancelledTransactions    int32                  `protobuf:"varint,8,opt,name=cancelled_transactions,json=cancelledTransactions,proto3" json:"cancelled_transactions,omitempty"`
+	PendingTransactions      int32                  `protobuf:"varint,9,opt,name=pending_transactions,json=pendingTransactions,proto3" json:"pending_transactions,omitempty"`
+	MedianAmount             float64                `protobuf:"fixed64,10,opt,name=median_amount,json=medianAmount,proto3" json:"median_amount,omitempty"` // approximate, like the other percentiles
+	P90Amount                float64                `protobuf:"fixed64,11,opt,name=p90_amount,json=p90Amount,proto3" json:"p90_amount,omitempty"`
+	P99Amount                float64                `protobuf:"fixed64,12,opt,name=p99_amount,json=p99Amount,proto3" json:"p99_amount,omitempty"`
+	Groups                   []*StatsGroup          `protobuf:"bytes,13,rep,name=groups,proto3" json:"groups,omitempty"` // one per combination of the requested group_by values, largest first
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *TransactionStatsResponse) Reset() {
+	*x = TransactionStatsResponse{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionStatsResponse) ProtoMessage() {}
+
+func (x *TransactionStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionStatsResponse.ProtoReflect.Descriptor instead.
+func (*TransactionStatsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TransactionStatsResponse) GetTotalTransactions() int32 {
+	if x != nil {
+		return x.TotalTransactions
+	}
+	return 0
+}
+
+func (x *TransactionStatsResponse) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *TransactionStatsResponse) GetSuccessfulTransactions() int32 {
+	if x != nil {
+		return x.SuccessfulTransactions
+	}
+	return 0
+}
+
+func (x *TransactionStatsResponse) GetFailedTransactions() int32 {
+	if x != nil {
+		return x.FailedTransactions
+	}
+	return 0
+}
+
+func (x *TransactionStatsResponse) GetAverageTransactionAmount() float64 {
+	if x != nil {
+		return x.AverageTransactionAmount
+	}
+	return 0
+}
+
+func (x *TransactionStatsResponse) GetTotalFeeRevenue() float64 {
+	if x != nil {
+		return x.TotalFeeRevenue
+	}
+	return 0
+}
+
+func (x *TransactionStatsResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TransactionStatsResponse) GetCancelledTransactions() int32 {
+	if x != nil {
+		return x.CancelledTransactions
+	}
+	return 0
+}
+
+func (x *TransactionStatsResponse) GetPendingTransactions() int32 {
+	if x != nil {
+		return x.PendingTransactions
+	}
+	return 0
+}
+
+func (x *TransactionStatsResponse) GetMedianAmount() float64 {
+	if x != nil {
+		return x.MedianAmount
+	}
+	return 0
+}
+
+func (x *TransactionStatsResponse) GetP90Amount() float64 {
+	if x != nil {
+		return x.P90Amount
+	}
+	return 0
+}
+
+func (x *TransactionStatsResponse) GetP99Amount() float64 {
+	if x != nil {
+		return x.P99Amount
+	}
+	return 0
+}
+
+func (x *TransactionStatsResponse) GetGroups() []*StatsGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// StatsGroup summarizes the transactions sharing the values of the requested
+// dimensions. Fields of dimensions that were not requested are empty.
+type StatsGroup struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Type                     string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status                   string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Role                     string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`     // "buyer" or "seller"
+	Rarity                   string                 `protobuf:"bytes,4,opt,name=rarity,proto3" json:"rarity,omitempty"` // empty for transactions without a single skin, such as trades
+	TotalTransactions        int32                  `protobuf:"varint,5,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
+	TotalAmount              float64                `protobuf:"fixed64,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	AverageTransactionAmount float64                `protobuf:"fixed64,7,opt,name=average_transaction_amount,json=averageTransactionAmount,proto3" json:"average_transaction_amount,omitempty"`
+	MedianAmount             float64                `protobuf:"fixed64,8,opt,name=median_amount,json=medianAmount,proto3" json:"median_amount,omitempty"` // approximate
+	TotalFeeRevenue          float64                `protobuf:"fixed64,9,opt,name=total_fee_revenue,json=totalFeeRevenue,proto3" json:"total_fee_revenue,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *StatsGroup) Reset() {
+	*x = StatsGroup{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsGroup) ProtoMessage() {}
+
+func (x *StatsGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsGroup.ProtoReflect.Descriptor instead.
+func (*StatsGroup) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StatsGroup) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StatsGroup) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatsGroup) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *StatsGroup) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *StatsGroup) GetTotalTransactions() int32 {
+	if x != nil {
+		return x.TotalTransactions
+	}
+	return 0
+}
+
+func (x *StatsGroup) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *StatsGroup) GetAverageTransactionAmount() float64 {
+	if x != nil {
+		return x.AverageTransactionAmount
+	}
+	return 0
+}
+
+func (x *StatsGroup) GetMedianAmount() float64 {
+	if x != nil {
+		return x.MedianAmount
+	}
+	return 0
+}
+
+func (x *StatsGroup) GetTotalFeeRevenue() float64 {
+	if x != nil {
+		return x.TotalFeeRevenue
+	}
+	return 0
+}
+
+type VolumeBucket struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BucketStart    string                 `protobuf:"bytes,1,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"` // RFC3339 in the requested timezone
+	Count          int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TotalAmount    float64                `protobuf:"fixed64,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	AverageAmount  float64                `protobuf:"fixed64,4,opt,name=average_amount,json=averageAmount,proto3" json:"average_amount,omitempty"`
+	CompletionRate float64                `protobuf:"fixed64,5,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"` // share of transactions in the bucket that completed
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VolumeBucket) Reset() {
+	*x = VolumeBucket{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeBucket) ProtoMessage() {}
+
+func (x *VolumeBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeBucket.ProtoReflect.Descriptor instead.
+func (*VolumeBucket) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VolumeBucket) GetBucketStart() string {
+	if x != nil {
+		return x.BucketStart
+	}
+	return ""
+}
+
+func (x *VolumeBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *VolumeBucket) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *VolumeBucket) GetAverageAmount() float64 {
+	if x != nil {
+		return x.AverageAmount
+	}
+	return 0
+}
+
+func (x *VolumeBucket) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+type TransactionVolumeSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Buckets       []*VolumeBucket        `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // amounts are in major units of this currency
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionVolumeSeriesResponse) Reset() {
+	*x = TransactionVolumeSeriesResponse{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionVolumeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionVolumeSeriesResponse) ProtoMessage() {}
+
+func (x *TransactionVolumeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionVolumeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*TransactionVolumeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TransactionVolumeSeriesResponse) GetBuckets() []*VolumeBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *TransactionVolumeSeriesResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// PriceCandle summarizes the completed sales within one UTC time bucket
+type PriceCandle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketStart   string                 `protobuf:"bytes,1,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"` // RFC3339
+	Open          *money.Money           `protobuf:"bytes,2,opt,name=open,proto3" json:"open,omitempty"`                                  // price of the first sale
+	High          *money.Money           `protobuf:"bytes,3,opt,name=high,proto3" json:"high,omitempty"`
+	Low           *money.Money           `protobuf:"bytes,4,opt,name=low,proto3" json:"low,omitempty"`
+	Close         *money.Money           `protobuf:"bytes,5,opt,name=close,proto3" json:"close,omitempty"`   // price of the last sale
+	Volume        *money.Money           `protobuf:"bytes,6,opt,name=volume,proto3" json:"volume,omitempty"` // sum of all sale prices
+	Sales         int64                  `protobuf:"varint,7,opt,name=sales,proto3" json:"sales,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceCandle) Reset() {
+	*x = PriceCandle{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceCandle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction) ProtoMessage() {}
+func (*PriceCandle) ProtoMessage() {}
 
-func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_transaction_proto_msgTypes[0]
+func (x *PriceCandle) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -159,102 +3949,86 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
-func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Transaction) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Transaction) GetBuyerId() string {
-	if x != nil {
-		return x.BuyerId
-	}
-	return ""
+// Deprecated: Use PriceCandle.ProtoReflect.Descriptor instead.
+func (*PriceCandle) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *Transaction) GetSellerId() string {
+func (x *PriceCandle) GetBucketStart() string {
 	if x != nil {
-		return x.SellerId
+		return x.BucketStart
 	}
 	return ""
 }
 
-func (x *Transaction) GetSkinId() string {
+func (x *PriceCandle) GetOpen() *money.Money {
 	if x != nil {
-		return x.SkinId
+		return x.Open
 	}
-	return ""
+	return nil
 }
 
-func (x *Transaction) GetAmount() float64 {
+func (x *PriceCandle) GetHigh() *money.Money {
 	if x != nil {
-		return x.Amount
+		return x.High
 	}
-	return 0
+	return nil
 }
 
-func (x *Transaction) GetDate() string {
+func (x *PriceCandle) GetLow() *money.Money {
 	if x != nil {
-		return x.Date
+		return x.Low
 	}
-	return ""
+	return nil
 }
 
-func (x *Transaction) GetStatus() TransactionStatus {
+func (x *PriceCandle) GetClose() *money.Money {
 	if x != nil {
-		return x.Status
+		return x.Close
 	}
-	return TransactionStatus_PENDING
+	return nil
 }
 
-func (x *Transaction) GetType() TransactionType {
+func (x *PriceCandle) GetVolume() *money.Money {
 	if x != nil {
-		return x.Type
+		return x.Volume
 	}
-	return TransactionType_BUY
+	return nil
 }
 
-func (x *Transaction) GetDescription() string {
+func (x *PriceCandle) GetSales() int64 {
 	if x != nil {
-		return x.Description
+		return x.Sales
 	}
-	return ""
+	return 0
 }
 
-// Request messages
-type CreateTransactionRequest struct {
+type SkinPriceHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BuyerId       string                 `protobuf:"bytes,1,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
-	SellerId      string                 `protobuf:"bytes,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
-	SkinId        string                 `protobuf:"bytes,3,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type          TransactionType        `protobuf:"varint,5,opt,name=type,proto3,enum=transaction.TransactionType" json:"type,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	SkinName      string                 `protobuf:"bytes,1,opt,name=skin_name,json=skinName,proto3" json:"skin_name,omitempty"`
+	Condition     string                 `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+	Interval      BucketInterval         `protobuf:"varint,3,opt,name=interval,proto3,enum=transaction.BucketInterval" json:"interval,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Candles       []*PriceCandle         `protobuf:"bytes,5,rep,name=candles,proto3" json:"candles,omitempty"` // oldest first; buckets without sales are omitted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTransactionRequest) Reset() {
-	*x = CreateTransactionRequest{}
-	mi := &file_shared_proto_transaction_proto_msgTypes[1]
+func (x *SkinPriceHistoryResponse) Reset() {
+	*x = SkinPriceHistoryResponse{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTransactionRequest) String() string {
+func (x *SkinPriceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTransactionRequest) ProtoMessage() {}
+func (*SkinPriceHistoryResponse) ProtoMessage() {}
 
-func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_transaction_proto_msgTypes[1]
+func (x *SkinPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -265,75 +4039,74 @@ func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTransactionRequest.ProtoReflect.Descriptor instead.
-func (*CreateTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *CreateTransactionRequest) GetBuyerId() string {
-	if x != nil {
-		return x.BuyerId
-	}
-	return ""
+// Deprecated: Use SkinPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*SkinPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *CreateTransactionRequest) GetSellerId() string {
+func (x *SkinPriceHistoryResponse) GetSkinName() string {
 	if x != nil {
-		return x.SellerId
+		return x.SkinName
 	}
 	return ""
 }
 
-func (x *CreateTransactionRequest) GetSkinId() string {
+func (x *SkinPriceHistoryResponse) GetCondition() string {
 	if x != nil {
-		return x.SkinId
+		return x.Condition
 	}
 	return ""
 }
 
-func (x *CreateTransactionRequest) GetAmount() float64 {
+func (x *SkinPriceHistoryResponse) GetInterval() BucketInterval {
 	if x != nil {
-		return x.Amount
+		return x.Interval
 	}
-	return 0
+	return BucketInterval_DAY
 }
 
-func (x *CreateTransactionRequest) GetType() TransactionType {
+func (x *SkinPriceHistoryResponse) GetCurrency() string {
 	if x != nil {
-		return x.Type
+		return x.Currency
 	}
-	return TransactionType_BUY
+	return ""
 }
 
-func (x *CreateTransactionRequest) GetDescription() string {
+func (x *SkinPriceHistoryResponse) GetCandles() []*PriceCandle {
 	if x != nil {
-		return x.Description
+		return x.Candles
 	}
-	return ""
+	return nil
 }
 
-type GetTransactionRequest struct {
+// LeaderboardEntry is a user's completed trading within the window
+type LeaderboardEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Volume        *money.Money           `protobuf:"bytes,4,opt,name=volume,proto3" json:"volume,omitempty"`
+	Transactions  int64                  `protobuf:"varint,5,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	Profit        *money.Money           `protobuf:"bytes,6,opt,name=profit,proto3" json:"profit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionRequest) Reset() {
-	*x = GetTransactionRequest{}
-	mi := &file_shared_proto_transaction_proto_msgTypes[2]
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionRequest) String() string {
+func (x *LeaderboardEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionRequest) ProtoMessage() {}
+func (*LeaderboardEntry) ProtoMessage() {}
 
-func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_transaction_proto_msgTypes[2]
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,104 +4117,79 @@ func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetTransactionRequest) GetId() string {
+func (x *LeaderboardEntry) GetRank() int32 {
 	if x != nil {
-		return x.Id
+		return x.Rank
 	}
-	return ""
-}
-
-type UpdateTransactionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        TransactionStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=transaction.TransactionStatus" json:"status,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateTransactionRequest) Reset() {
-	*x = UpdateTransactionRequest{}
-	mi := &file_shared_proto_transaction_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateTransactionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*UpdateTransactionRequest) ProtoMessage() {}
-
-func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_transaction_proto_msgTypes[3]
+func (x *LeaderboardEntry) GetUserId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UserId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateTransactionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{3}
+func (x *LeaderboardEntry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-func (x *UpdateTransactionRequest) GetId() string {
+func (x *LeaderboardEntry) GetVolume() *money.Money {
 	if x != nil {
-		return x.Id
+		return x.Volume
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateTransactionRequest) GetStatus() TransactionStatus {
+func (x *LeaderboardEntry) GetTransactions() int64 {
 	if x != nil {
-		return x.Status
+		return x.Transactions
 	}
-	return TransactionStatus_PENDING
+	return 0
 }
 
-func (x *UpdateTransactionRequest) GetDescription() string {
+func (x *LeaderboardEntry) GetProfit() *money.Money {
 	if x != nil {
-		return x.Description
+		return x.Profit
 	}
-	return ""
+	return nil
 }
 
-type GetTransactionsByUserRequest struct {
+type LeaderboardResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status        TransactionStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=transaction.TransactionStatus" json:"status,omitempty"` // optional filter
-	Type          TransactionType        `protobuf:"varint,3,opt,name=type,proto3,enum=transaction.TransactionType" json:"type,omitempty"`       // optional filter
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                      // optional limit
-	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`                                    // optional offset
+	Metric        LeaderboardMetric      `protobuf:"varint,1,opt,name=metric,proto3,enum=transaction.LeaderboardMetric" json:"metric,omitempty"`
+	Window        LeaderboardWindow      `protobuf:"varint,2,opt,name=window,proto3,enum=transaction.LeaderboardWindow" json:"window,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Entries       []*LeaderboardEntry    `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`                            // users who opted out are left out
+	GeneratedAt   string                 `protobuf:"bytes,5,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"` // RFC3339 - leaderboards are refreshed periodically
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionsByUserRequest) Reset() {
-	*x = GetTransactionsByUserRequest{}
-	mi := &file_shared_proto_transaction_proto_msgTypes[4]
+func (x *LeaderboardResponse) Reset() {
+	*x = LeaderboardResponse{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsByUserRequest) String() string {
+func (x *LeaderboardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsByUserRequest) ProtoMessage() {}
+func (*LeaderboardResponse) ProtoMessage() {}
 
-func (x *GetTransactionsByUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_transaction_proto_msgTypes[4]
+func (x *LeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,68 +4200,72 @@ func (x *GetTransactionsByUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsByUserRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionsByUserRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use LeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*LeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetTransactionsByUserRequest) GetUserId() string {
+func (x *LeaderboardResponse) GetMetric() LeaderboardMetric {
 	if x != nil {
-		return x.UserId
+		return x.Metric
 	}
-	return ""
+	return LeaderboardMetric_LEADERBOARD_VOLUME
 }
 
-func (x *GetTransactionsByUserRequest) GetStatus() TransactionStatus {
+func (x *LeaderboardResponse) GetWindow() LeaderboardWindow {
 	if x != nil {
-		return x.Status
+		return x.Window
 	}
-	return TransactionStatus_PENDING
+	return LeaderboardWindow_WINDOW_DAY
 }
 
-func (x *GetTransactionsByUserRequest) GetType() TransactionType {
+func (x *LeaderboardResponse) GetCurrency() string {
 	if x != nil {
-		return x.Type
+		return x.Currency
 	}
-	return TransactionType_BUY
+	return ""
 }
 
-func (x *GetTransactionsByUserRequest) GetLimit() int32 {
+func (x *LeaderboardResponse) GetEntries() []*LeaderboardEntry {
 	if x != nil {
-		return x.Limit
+		return x.Entries
 	}
-	return 0
+	return nil
 }
 
-func (x *GetTransactionsByUserRequest) GetOffset() int32 {
+func (x *LeaderboardResponse) GetGeneratedAt() string {
 	if x != nil {
-		return x.Offset
+		return x.GeneratedAt
 	}
-	return 0
+	return ""
 }
 
-type GetTransactionsBySkinRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SkinId        string                 `protobuf:"bytes,1,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type TransactionEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           TransactionEventType   `protobuf:"varint,1,opt,name=type,proto3,enum=transaction.TransactionEventType" json:"type,omitempty"`
+	Transaction    *Transaction           `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	PreviousStatus TransactionStatus      `protobuf:"varint,3,opt,name=previous_status,json=previousStatus,proto3,enum=transaction.TransactionStatus" json:"previous_status,omitempty"` // set for TRANSACTION_STATUS_CHANGED
+	OccurredAt     string                 `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`                                                 // RFC3339
+	ResumeToken    string                 `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetTransactionsBySkinRequest) Reset() {
-	*x = GetTransactionsBySkinRequest{}
-	mi := &file_shared_proto_transaction_proto_msgTypes[5]
+func (x *TransactionEvent) Reset() {
+	*x = TransactionEvent{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsBySkinRequest) String() string {
+func (x *TransactionEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsBySkinRequest) ProtoMessage() {}
+func (*TransactionEvent) ProtoMessage() {}
 
-func (x *GetTransactionsBySkinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_transaction_proto_msgTypes[5]
+func (x *TransactionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,42 +4276,88 @@ func (x *GetTransactionsBySkinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsBySkinRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionsBySkinRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use TransactionEvent.ProtoReflect.Descriptor instead.
+func (*TransactionEvent) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetTransactionsBySkinRequest) GetSkinId() string {
+func (x *TransactionEvent) GetType() TransactionEventType {
 	if x != nil {
-		return x.SkinId
+		return x.Type
+	}
+	return TransactionEventType_TRANSACTION_CREATED
+}
+
+func (x *TransactionEvent) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TransactionEvent) GetPreviousStatus() TransactionStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return TransactionStatus_PENDING
+}
+
+func (x *TransactionEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
 	}
 	return ""
 }
 
-type GetTransactionsByStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        TransactionStatus      `protobuf:"varint,1,opt,name=status,proto3,enum=transaction.TransactionStatus" json:"status,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TransactionEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
 }
 
-func (x *GetTransactionsByStatusRequest) Reset() {
-	*x = GetTransactionsByStatusRequest{}
-	mi := &file_shared_proto_transaction_proto_msgTypes[6]
+// LifecycleEvent is the payload published to NATS on the subjects
+// transaction.created, transaction.completed, transaction.failed and
+// transaction.cancelled
+type LifecycleEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EventId        string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // unique per event; redelivered events keep their id
+	Subject        string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	TransactionId  string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Type           TransactionType        `protobuf:"varint,4,opt,name=type,proto3,enum=transaction.TransactionType" json:"type,omitempty"`
+	Status         TransactionStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=transaction.TransactionStatus" json:"status,omitempty"`
+	PreviousStatus *TransactionStatus     `protobuf:"varint,6,opt,name=previous_status,json=previousStatus,proto3,enum=transaction.TransactionStatus,oneof" json:"previous_status,omitempty"` // unset for transaction.created
+	BuyerId        string                 `protobuf:"bytes,7,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	SellerId       string                 `protobuf:"bytes,8,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	SkinId         string                 `protobuf:"bytes,9,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`                         // unset for trades
+	BuyerSkinIds   []string               `protobuf:"bytes,10,rep,name=buyer_skin_ids,json=buyerSkinIds,proto3" json:"buyer_skin_ids,omitempty"`    // set on trades
+	SellerSkinIds  []string               `protobuf:"bytes,11,rep,name=seller_skin_ids,json=sellerSkinIds,proto3" json:"seller_skin_ids,omitempty"` // set on trades
+	OrderId        string                 `protobuf:"bytes,12,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                     // set on cart purchases
+	Amount         *money.Money           `protobuf:"bytes,13,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeAmount      *money.Money           `protobuf:"bytes,14,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	SellerProceeds *money.Money           `protobuf:"bytes,15,opt,name=seller_proceeds,json=sellerProceeds,proto3" json:"seller_proceeds,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // RFC3339
+	UpdatedAt      string                 `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`    // RFC3339
+	OccurredAt     string                 `protobuf:"bytes,18,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // RFC3339
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LifecycleEvent) Reset() {
+	*x = LifecycleEvent{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsByStatusRequest) String() string {
+func (x *LifecycleEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsByStatusRequest) ProtoMessage() {}
+func (*LifecycleEvent) ProtoMessage() {}
 
-func (x *GetTransactionsByStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_transaction_proto_msgTypes[6]
+func (x *LifecycleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -570,160 +4368,160 @@ func (x *GetTransactionsByStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsByStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionsByStatusRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *GetTransactionsByStatusRequest) GetStatus() TransactionStatus {
-	if x != nil {
-		return x.Status
-	}
-	return TransactionStatus_PENDING
+// Deprecated: Use LifecycleEvent.ProtoReflect.Descriptor instead.
+func (*LifecycleEvent) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetTransactionsByStatusRequest) GetLimit() int32 {
+func (x *LifecycleEvent) GetEventId() string {
 	if x != nil {
-		return x.Limit
+		return x.EventId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTransactionsByStatusRequest) GetOffset() int32 {
+func (x *LifecycleEvent) GetSubject() string {
 	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
-
-type ProcessPurchaseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BuyerId       string                 `protobuf:"bytes,1,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
-	SkinId        string                 `protobuf:"bytes,2,opt,name=skin_id,json=skinId,proto3" json:"skin_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ProcessPurchaseRequest) Reset() {
-	*x = ProcessPurchaseRequest{}
-	mi := &file_shared_proto_transaction_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+		return x.Subject
+	}
+	return ""
 }
 
-func (x *ProcessPurchaseRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LifecycleEvent) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
 }
 
-func (*ProcessPurchaseRequest) ProtoMessage() {}
+func (x *LifecycleEvent) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_BUY
+}
 
-func (x *ProcessPurchaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_transaction_proto_msgTypes[7]
+func (x *LifecycleEvent) GetStatus() TransactionStatus {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return TransactionStatus_PENDING
 }
 
-// Deprecated: Use ProcessPurchaseRequest.ProtoReflect.Descriptor instead.
-func (*ProcessPurchaseRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{7}
+func (x *LifecycleEvent) GetPreviousStatus() TransactionStatus {
+	if x != nil && x.PreviousStatus != nil {
+		return *x.PreviousStatus
+	}
+	return TransactionStatus_PENDING
 }
 
-func (x *ProcessPurchaseRequest) GetBuyerId() string {
+func (x *LifecycleEvent) GetBuyerId() string {
 	if x != nil {
 		return x.BuyerId
 	}
 	return ""
 }
 
-func (x *ProcessPurchaseRequest) GetSkinId() string {
+func (x *LifecycleEvent) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *LifecycleEvent) GetSkinId() string {
 	if x != nil {
 		return x.SkinId
 	}
 	return ""
 }
 
-type CancelTransactionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LifecycleEvent) GetBuyerSkinIds() []string {
+	if x != nil {
+		return x.BuyerSkinIds
+	}
+	return nil
 }
 
-func (x *CancelTransactionRequest) Reset() {
-	*x = CancelTransactionRequest{}
-	mi := &file_shared_proto_transaction_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *LifecycleEvent) GetSellerSkinIds() []string {
+	if x != nil {
+		return x.SellerSkinIds
+	}
+	return nil
 }
 
-func (x *CancelTransactionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LifecycleEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
 }
 
-func (*CancelTransactionRequest) ProtoMessage() {}
+func (x *LifecycleEvent) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
 
-func (x *CancelTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_transaction_proto_msgTypes[8]
+func (x *LifecycleEvent) GetFeeAmount() *money.Money {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FeeAmount
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CancelTransactionRequest.ProtoReflect.Descriptor instead.
-func (*CancelTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{8}
+func (x *LifecycleEvent) GetSellerProceeds() *money.Money {
+	if x != nil {
+		return x.SellerProceeds
+	}
+	return nil
 }
 
-func (x *CancelTransactionRequest) GetId() string {
+func (x *LifecycleEvent) GetCreatedAt() string {
 	if x != nil {
-		return x.Id
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *CancelTransactionRequest) GetReason() string {
+func (x *LifecycleEvent) GetUpdatedAt() string {
 	if x != nil {
-		return x.Reason
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-type GetTransactionStatsRequest struct {
+func (x *LifecycleEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+// ExportChunk carries the next part of an export; concatenated chunks form the file
+type ExportChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // optional - if empty, gets global stats
-	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // optional
-	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // optional
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionStatsRequest) Reset() {
-	*x = GetTransactionStatsRequest{}
-	mi := &file_shared_proto_transaction_proto_msgTypes[9]
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionStatsRequest) String() string {
+func (x *ExportChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionStatsRequest) ProtoMessage() {}
+func (*ExportChunk) ProtoMessage() {}
 
-func (x *GetTransactionStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_transaction_proto_msgTypes[9]
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,55 +4532,41 @@ func (x *GetTransactionStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionStatsRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *GetTransactionStatsRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *GetTransactionStatsRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetTransactionStatsRequest) GetEndDate() string {
+func (x *ExportChunk) GetData() []byte {
 	if x != nil {
-		return x.EndDate
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-// Response messages
-type TransactionResponse struct {
+type TransactionHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	History       []*StatusChange        `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"` // oldest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionResponse) Reset() {
-	*x = TransactionResponse{}
-	mi := &file_shared_proto_transaction_proto_msgTypes[10]
+func (x *TransactionHistoryResponse) Reset() {
+	*x = TransactionHistoryResponse{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionResponse) String() string {
+func (x *TransactionHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionResponse) ProtoMessage() {}
+func (*TransactionHistoryResponse) ProtoMessage() {}
 
-func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_transaction_proto_msgTypes[10]
+func (x *TransactionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,41 +4577,48 @@ func (x *TransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionResponse.ProtoReflect.Descriptor instead.
-func (*TransactionResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use TransactionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*TransactionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *TransactionResponse) GetTransaction() *Transaction {
+func (x *TransactionHistoryResponse) GetTransactionId() string {
 	if x != nil {
-		return x.Transaction
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *TransactionHistoryResponse) GetHistory() []*StatusChange {
+	if x != nil {
+		return x.History
 	}
 	return nil
 }
 
-type TransactionListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DisputeResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Dispute           *Dispute               `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute,omitempty"`
+	RefundTransaction *Transaction           `protobuf:"bytes,2,opt,name=refund_transaction,json=refundTransaction,proto3" json:"refund_transaction,omitempty"` // set when the dispute was refunded
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *TransactionListResponse) Reset() {
-	*x = TransactionListResponse{}
-	mi := &file_shared_proto_transaction_proto_msgTypes[11]
+func (x *DisputeResponse) Reset() {
+	*x = DisputeResponse{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionListResponse) String() string {
+func (x *DisputeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionListResponse) ProtoMessage() {}
+func (*DisputeResponse) ProtoMessage() {}
 
-func (x *TransactionListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_transaction_proto_msgTypes[11]
+func (x *DisputeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,48 +4629,48 @@ func (x *TransactionListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionListResponse.ProtoReflect.Descriptor instead.
-func (*TransactionListResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DisputeResponse.ProtoReflect.Descriptor instead.
+func (*DisputeResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *TransactionListResponse) GetTransactions() []*Transaction {
+func (x *DisputeResponse) GetDispute() *Dispute {
 	if x != nil {
-		return x.Transactions
+		return x.Dispute
 	}
 	return nil
 }
 
-func (x *TransactionListResponse) GetTotalCount() int32 {
+func (x *DisputeResponse) GetRefundTransaction() *Transaction {
 	if x != nil {
-		return x.TotalCount
+		return x.RefundTransaction
 	}
-	return 0
+	return nil
 }
 
-type DeleteResponse struct {
+type DisputeListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Disputes      []*Dispute             `protobuf:"bytes,1,rep,name=disputes,proto3" json:"disputes,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteResponse) Reset() {
-	*x = DeleteResponse{}
-	mi := &file_shared_proto_transaction_proto_msgTypes[12]
+func (x *DisputeListResponse) Reset() {
+	*x = DisputeListResponse{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResponse) String() string {
+func (x *DisputeListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResponse) ProtoMessage() {}
+func (*DisputeListResponse) ProtoMessage() {}
 
-func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_transaction_proto_msgTypes[12]
+func (x *DisputeListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,51 +4681,52 @@ func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DisputeListResponse.ProtoReflect.Descriptor instead.
+func (*DisputeListResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *DeleteResponse) GetSuccess() bool {
+func (x *DisputeListResponse) GetDisputes() []*Dispute {
 	if x != nil {
-		return x.Success
+		return x.Disputes
 	}
-	return false
+	return nil
 }
 
-func (x *DeleteResponse) GetMessage() string {
+func (x *DisputeListResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.Message
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-type TransactionStatsResponse struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	TotalTransactions        int32                  `protobuf:"varint,1,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
-	TotalAmount              float64                `protobuf:"fixed64,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	SuccessfulTransactions   int32                  `protobuf:"varint,3,opt,name=successful_transactions,json=successfulTransactions,proto3" json:"successful_transactions,omitempty"`
-	FailedTransactions       int32                  `protobuf:"varint,4,opt,name=failed_transactions,json=failedTransactions,proto3" json:"failed_transactions,omitempty"`
-	AverageTransactionAmount float64                `protobuf:"fixed64,5,opt,name=average_transaction_amount,json=averageTransactionAmount,proto3" json:"average_transaction_amount,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type QuoteFeesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FeePercent     float64                `protobuf:"fixed64,2,opt,name=fee_percent,json=feePercent,proto3" json:"fee_percent,omitempty"`
+	Exempt         bool                   `protobuf:"varint,6,opt,name=exempt,proto3" json:"exempt,omitempty"`
+	Amount         *money.Money           `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	FixedFee       *money.Money           `protobuf:"bytes,8,opt,name=fixed_fee,json=fixedFee,proto3" json:"fixed_fee,omitempty"`
+	FeeAmount      *money.Money           `protobuf:"bytes,9,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	SellerProceeds *money.Money           `protobuf:"bytes,10,opt,name=seller_proceeds,json=sellerProceeds,proto3" json:"seller_proceeds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *TransactionStatsResponse) Reset() {
-	*x = TransactionStatsResponse{}
-	mi := &file_shared_proto_transaction_proto_msgTypes[13]
+func (x *QuoteFeesResponse) Reset() {
+	*x = QuoteFeesResponse{}
+	mi := &file_shared_proto_transaction_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionStatsResponse) String() string {
+func (x *QuoteFeesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionStatsResponse) ProtoMessage() {}
+func (*QuoteFeesResponse) ProtoMessage() {}
 
-func (x *TransactionStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_transaction_proto_msgTypes[13]
+func (x *QuoteFeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_transaction_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -945,134 +4737,533 @@ func (x *TransactionStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionStatsResponse.ProtoReflect.Descriptor instead.
-func (*TransactionStatsResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QuoteFeesResponse.ProtoReflect.Descriptor instead.
+func (*QuoteFeesResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_transaction_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *TransactionStatsResponse) GetTotalTransactions() int32 {
+func (x *QuoteFeesResponse) GetFeePercent() float64 {
 	if x != nil {
-		return x.TotalTransactions
+		return x.FeePercent
 	}
 	return 0
 }
 
-func (x *TransactionStatsResponse) GetTotalAmount() float64 {
+func (x *QuoteFeesResponse) GetExempt() bool {
 	if x != nil {
-		return x.TotalAmount
+		return x.Exempt
 	}
-	return 0
+	return false
 }
 
-func (x *TransactionStatsResponse) GetSuccessfulTransactions() int32 {
+func (x *QuoteFeesResponse) GetAmount() *money.Money {
 	if x != nil {
-		return x.SuccessfulTransactions
+		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-func (x *TransactionStatsResponse) GetFailedTransactions() int32 {
+func (x *QuoteFeesResponse) GetFixedFee() *money.Money {
 	if x != nil {
-		return x.FailedTransactions
+		return x.FixedFee
 	}
-	return 0
+	return nil
 }
 
-func (x *TransactionStatsResponse) GetAverageTransactionAmount() float64 {
+func (x *QuoteFeesResponse) GetFeeAmount() *money.Money {
 	if x != nil {
-		return x.AverageTransactionAmount
+		return x.FeeAmount
 	}
-	return 0
+	return nil
+}
+
+func (x *QuoteFeesResponse) GetSellerProceeds() *money.Money {
+	if x != nil {
+		return x.SellerProceeds
+	}
+	return nil
 }
 
 var File_shared_proto_transaction_proto protoreflect.FileDescriptor
 
 const file_shared_proto_transaction_proto_rawDesc = "" +
 	"\n" +
-	"\x1eshared/proto/transaction.proto\x12\vtransaction\"\xa6\x02\n" +
+	"\x1eshared/proto/transaction.proto\x12\vtransaction\x1a\x18shared/proto/money.proto\"\x9c\a\n" +
 	"\vTransaction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bbuyer_id\x18\x02 \x01(\tR\abuyerId\x12\x1b\n" +
 	"\tseller_id\x18\x03 \x01(\tR\bsellerId\x12\x17\n" +
-	"\askin_id\x18\x04 \x01(\tR\x06skinId\x12\x16\n" +
-	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x12\n" +
+	"\askin_id\x18\x04 \x01(\tR\x06skinId\x12\x12\n" +
 	"\x04date\x18\x06 \x01(\tR\x04date\x126\n" +
 	"\x06status\x18\a \x01(\x0e2\x1e.transaction.TransactionStatusR\x06status\x120\n" +
 	"\x04type\x18\b \x01(\x0e2\x1c.transaction.TransactionTypeR\x04type\x12 \n" +
-	"\vdescription\x18\t \x01(\tR\vdescription\"\xd7\x01\n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x03R\aversion\x12\x17\n" +
+	"\ahold_id\x18\v \x01(\tR\x06holdId\x12 \n" +
+	"\frefund_of_id\x18\x0e \x01(\tR\n" +
+	"refundOfId\x122\n" +
+	"\x15refund_transaction_id\x18\x0f \x01(\tR\x13refundTransactionId\x12\x1d\n" +
+	"\n" +
+	"dispute_id\x18\x10 \x01(\tR\tdisputeId\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x11 \x01(\tR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x12 \x01(\tR\tdeletedBy\x12\x1a\n" +
+	"\barchived\x18\x13 \x01(\bR\barchived\x12$\n" +
+	"\x06amount\x18\x14 \x01(\v2\f.money.MoneyR\x06amount\x12+\n" +
+	"\n" +
+	"fee_amount\x18\x15 \x01(\v2\f.money.MoneyR\tfeeAmount\x125\n" +
+	"\x0fseller_proceeds\x18\x16 \x01(\v2\f.money.MoneyR\x0esellerProceeds\x123\n" +
+	"\vconversions\x18\x17 \x03(\v2\x11.money.ConversionR\vconversions\x12$\n" +
+	"\x0ebuyer_skin_ids\x18\x18 \x03(\tR\fbuyerSkinIds\x12&\n" +
+	"\x0fseller_skin_ids\x18\x19 \x03(\tR\rsellerSkinIds\x12\x1f\n" +
+	"\vproposer_id\x18\x1a \x01(\tR\n" +
+	"proposerId\x12\x19\n" +
+	"\border_id\x18\x1b \x01(\tR\aorderId\x12/\n" +
+	"\x04risk\x18\x1c \x01(\v2\x1b.transaction.RiskAssessmentR\x04riskJ\x04\b\x05\x10\x06J\x04\b\f\x10\rJ\x04\b\r\x10\x0e\"}\n" +
+	"\x0eRiskAssessment\x125\n" +
+	"\bdecision\x18\x01 \x01(\x0e2\x19.transaction.RiskDecisionR\bdecision\x124\n" +
+	"\bfindings\x18\x02 \x03(\v2\x18.transaction.RiskFindingR\bfindings\"p\n" +
+	"\vRiskFinding\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x125\n" +
+	"\bdecision\x18\x02 \x01(\x0e2\x19.transaction.RiskDecisionR\bdecision\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xf2\x01\n" +
+	"\fStatusChange\x12D\n" +
+	"\vfrom_status\x18\x01 \x01(\x0e2\x1e.transaction.TransactionStatusH\x00R\n" +
+	"fromStatus\x88\x01\x01\x12;\n" +
+	"\tto_status\x18\x02 \x01(\x0e2\x1e.transaction.TransactionStatusR\btoStatus\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\tR\ttimestampB\x0e\n" +
+	"\f_from_status\"\x9b\x02\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bbuyer_id\x18\x02 \x01(\tR\abuyerId\x120\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x18.transaction.OrderStatusR\x06status\x12\"\n" +
+	"\x05total\x18\x04 \x01(\v2\f.money.MoneyR\x05total\x12,\n" +
+	"\x05items\x18\x05 \x03(\v2\x16.transaction.OrderItemR\x05items\x12%\n" +
+	"\x0efailure_reason\x18\x06 \x01(\tR\rfailureReason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"\xa2\x01\n" +
+	"\tOrderItem\x12\x17\n" +
+	"\askin_id\x18\x01 \x01(\tR\x06skinId\x12\x1b\n" +
+	"\tseller_id\x18\x02 \x01(\tR\bsellerId\x12\"\n" +
+	"\x05price\x18\x03 \x01(\v2\f.money.MoneyR\x05price\x12%\n" +
+	"\x0etransaction_id\x18\x04 \x01(\tR\rtransactionId\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xf7\x03\n" +
+	"\aReceipt\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\tR\x06number\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x19\n" +
+	"\bbuyer_id\x18\x03 \x01(\tR\abuyerId\x12%\n" +
+	"\x0ebuyer_username\x18\x04 \x01(\tR\rbuyerUsername\x12\x1b\n" +
+	"\tseller_id\x18\x05 \x01(\tR\bsellerId\x12'\n" +
+	"\x0fseller_username\x18\x06 \x01(\tR\x0esellerUsername\x12.\n" +
+	"\x05items\x18\a \x03(\v2\x18.transaction.ReceiptItemR\x05items\x12(\n" +
+	"\bsubtotal\x18\b \x01(\v2\f.money.MoneyR\bsubtotal\x12+\n" +
+	"\n" +
+	"fee_amount\x18\t \x01(\v2\f.money.MoneyR\tfeeAmount\x125\n" +
+	"\x0fseller_proceeds\x18\n" +
+	" \x01(\v2\f.money.MoneyR\x0esellerProceeds\x12\"\n" +
+	"\x05total\x18\v \x01(\v2\f.money.MoneyR\x05total\x12\x1b\n" +
+	"\tissued_at\x18\f \x01(\tR\bissuedAt\x12\x12\n" +
+	"\x04html\x18\r \x01(\tR\x04html\x12\x12\n" +
+	"\x04text\x18\x0e \x01(\tR\x04text\"\x94\x01\n" +
+	"\vReceiptItem\x12\x17\n" +
+	"\askin_id\x18\x01 \x01(\tR\x06skinId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tcondition\x18\x03 \x01(\tR\tcondition\x12\x16\n" +
+	"\x06rarity\x18\x04 \x01(\tR\x06rarity\x12\"\n" +
+	"\x05price\x18\x05 \x01(\v2\f.money.MoneyR\x05price\"\xbf\x03\n" +
+	"\aDispute\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12\x19\n" +
+	"\bbuyer_id\x18\x03 \x01(\tR\abuyerId\x12\x1b\n" +
+	"\tseller_id\x18\x04 \x01(\tR\bsellerId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bevidence\x18\x06 \x01(\tR\bevidence\x122\n" +
+	"\x06status\x18\a \x01(\x0e2\x1a.transaction.DisputeStatusR\x06status\x12\x1f\n" +
+	"\vresolved_by\x18\b \x01(\tR\n" +
+	"resolvedBy\x12'\n" +
+	"\x0fresolution_note\x18\t \x01(\tR\x0eresolutionNote\x12\x1f\n" +
+	"\vreturn_item\x18\n" +
+	" \x01(\bR\n" +
+	"returnItem\x122\n" +
+	"\x15refund_transaction_id\x18\v \x01(\tR\x13refundTransactionId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\x12\x1f\n" +
+	"\vresolved_at\x18\r \x01(\tR\n" +
+	"resolvedAt\"\xc9\x02\n" +
 	"\x18CreateTransactionRequest\x12\x19\n" +
 	"\bbuyer_id\x18\x01 \x01(\tR\abuyerId\x12\x1b\n" +
 	"\tseller_id\x18\x02 \x01(\tR\bsellerId\x12\x17\n" +
-	"\askin_id\x18\x03 \x01(\tR\x06skinId\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x01R\x06amount\x120\n" +
+	"\askin_id\x18\x03 \x01(\tR\x06skinId\x120\n" +
 	"\x04type\x18\x05 \x01(\x0e2\x1c.transaction.TransactionTypeR\x04type\x12 \n" +
-	"\vdescription\x18\x06 \x01(\tR\vdescription\"'\n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\x12$\n" +
+	"\x06amount\x18\b \x01(\v2\f.money.MoneyR\x06amount\x123\n" +
+	"\vconversions\x18\t \x03(\v2\x11.money.ConversionR\vconversionsJ\x04\b\x04\x10\x05\"\xe0\x02\n" +
+	"\x12CreateTradeRequest\x12\x1f\n" +
+	"\vproposer_id\x18\x01 \x01(\tR\n" +
+	"proposerId\x12\x19\n" +
+	"\bbuyer_id\x18\x02 \x01(\tR\abuyerId\x12\x1b\n" +
+	"\tseller_id\x18\x03 \x01(\tR\bsellerId\x12$\n" +
+	"\x0ebuyer_skin_ids\x18\x04 \x03(\tR\fbuyerSkinIds\x12&\n" +
+	"\x0fseller_skin_ids\x18\x05 \x03(\tR\rsellerSkinIds\x12#\n" +
+	"\x06top_up\x18\x06 \x01(\v2\f.money.MoneyR\x05topUp\x123\n" +
+	"\vconversions\x18\a \x03(\v2\x11.money.ConversionR\vconversions\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12'\n" +
+	"\x0fidempotency_key\x18\t \x01(\tR\x0eidempotencyKey\"T\n" +
+	"\x12AcceptTradeRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"m\n" +
 	"\x15GetTransactionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x84\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12)\n" +
+	"\x10include_archived\x18\x03 \x01(\bR\x0fincludeArchived\"\xf2\x01\n" +
 	"\x18UpdateTransactionRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x1e.transaction.TransactionStatusR\x06status\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"\xcf\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1e.transaction.TransactionStatusH\x00R\x06status\x88\x01\x01\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reasonB\t\n" +
+	"\a_status\"\xb7\x02\n" +
 	"\x1cGetTransactionsByUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x126\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x1e.transaction.TransactionStatusR\x06status\x120\n" +
-	"\x04type\x18\x03 \x01(\x0e2\x1c.transaction.TransactionTypeR\x04type\x12\x14\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12;\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1e.transaction.TransactionStatusH\x00R\x06status\x88\x01\x01\x125\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1c.transaction.TransactionTypeH\x01R\x04type\x88\x01\x01\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x05 \x01(\x05R\x06offset\"7\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12)\n" +
+	"\x10include_archived\x18\a \x01(\bR\x0fincludeArchivedB\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_type\"\x97\x01\n" +
 	"\x1cGetTransactionsBySkinRequest\x12\x17\n" +
-	"\askin_id\x18\x01 \x01(\tR\x06skinId\"\x86\x01\n" +
+	"\askin_id\x18\x01 \x01(\tR\x06skinId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12)\n" +
+	"\x10include_archived\x18\x04 \x01(\bR\x0fincludeArchived\"\xd0\x01\n" +
 	"\x1eGetTransactionsByStatusRequest\x126\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x1e.transaction.TransactionStatusR\x06status\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"L\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12)\n" +
+	"\x10include_archived\x18\x05 \x01(\bR\x0fincludeArchived\"\xaa\x01\n" +
 	"\x16ProcessPurchaseRequest\x12\x19\n" +
 	"\bbuyer_id\x18\x01 \x01(\tR\abuyerId\x12\x17\n" +
-	"\askin_id\x18\x02 \x01(\tR\x06skinId\"B\n" +
+	"\askin_id\x18\x02 \x01(\tR\x06skinId\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\x123\n" +
+	"\vconversions\x18\x04 \x03(\v2\x11.money.ConversionR\vconversions\"\x87\x01\n" +
+	"\x1aProcessCartPurchaseRequest\x12\x19\n" +
+	"\bbuyer_id\x18\x01 \x01(\tR\abuyerId\x12\x19\n" +
+	"\bskin_ids\x18\x02 \x03(\tR\askinIds\x123\n" +
+	"\vconversions\x18\x03 \x03(\v2\x11.money.ConversionR\vconversions\":\n" +
+	"\x11GetReceiptRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\"\xc1\x01\n" +
+	"\x10QuoteFeesRequest\x12\x17\n" +
+	"\askin_id\x18\x02 \x01(\tR\x06skinId\x12\x16\n" +
+	"\x06rarity\x18\x03 \x01(\tR\x06rarity\x12\x1b\n" +
+	"\tseller_id\x18\x04 \x01(\tR\bsellerId\x12$\n" +
+	"\x06amount\x18\x05 \x01(\v2\f.money.MoneyR\x06amount\x123\n" +
+	"\vconversions\x18\x06 \x03(\v2\x11.money.ConversionR\vconversionsJ\x04\b\x01\x10\x02\"\\\n" +
+	"\x19RestoreTransactionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vadmin_token\x18\x03 \x01(\tR\n" +
+	"adminTokenJ\x04\b\x02\x10\x03R\badmin_id\"\x88\x01\n" +
 	"\x18CancelTransactionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"o\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\"\xc3\x01\n" +
 	"\x1aGetTransactionStatsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x03 \x01(\tR\aendDate\"Q\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x126\n" +
+	"\bgroup_by\x18\x05 \x03(\x0e2\x1b.transaction.StatsDimensionR\agroupBy\"\xc8\x02\n" +
+	"!GetTransactionVolumeSeriesRequest\x127\n" +
+	"\binterval\x18\x01 \x01(\x0e2\x1b.transaction.BucketIntervalR\binterval\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\tR\aendTime\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x17\n" +
+	"\askin_id\x18\x06 \x01(\tR\x06skinId\x12;\n" +
+	"\x06status\x18\a \x01(\x0e2\x1e.transaction.TransactionStatusH\x00R\x06status\x88\x01\x01\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrencyB\t\n" +
+	"\a_status\"\xe6\x01\n" +
+	"\x1aGetSkinPriceHistoryRequest\x12\x1b\n" +
+	"\tskin_name\x18\x01 \x01(\tR\bskinName\x12\x1c\n" +
+	"\tcondition\x18\x02 \x01(\tR\tcondition\x127\n" +
+	"\binterval\x18\x03 \x01(\x0e2\x1b.transaction.BucketIntervalR\binterval\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x05 \x01(\tR\aendTime\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\"\xb9\x01\n" +
+	"\x15GetLeaderboardRequest\x126\n" +
+	"\x06metric\x18\x01 \x01(\x0e2\x1e.transaction.LeaderboardMetricR\x06metric\x126\n" +
+	"\x06window\x18\x02 \x01(\x0e2\x1e.transaction.LeaderboardWindowR\x06window\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"\x96\x01\n" +
+	"\x18WatchTransactionsRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\askin_id\x18\x03 \x01(\tR\x06skinId\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken\"\xa1\x01\n" +
+	"\x19ExportTransactionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\x121\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x19.transaction.ExportFormatR\x06format\"\x8a\x01\n" +
+	"\x12OpenDisputeRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x19\n" +
+	"\bbuyer_id\x18\x02 \x01(\tR\abuyerId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bevidence\x18\x04 \x01(\tR\bevidence\"#\n" +
+	"\x11GetDisputeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb8\x01\n" +
+	"\x13ListDisputesRequest\x127\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.transaction.DisputeStatusH\x00R\x06status\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x1f\n" +
+	"\vadmin_token\x18\x05 \x01(\tR\n" +
+	"adminTokenB\t\n" +
+	"\a_statusJ\x04\b\x01\x10\x02R\badmin_id\"\xa5\x01\n" +
+	"\x15ResolveDisputeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vadmin_token\x18\x06 \x01(\tR\n" +
+	"adminToken\x12\x16\n" +
+	"\x06refund\x18\x03 \x01(\bR\x06refund\x12\x1f\n" +
+	"\vreturn_item\x18\x04 \x01(\bR\n" +
+	"returnItem\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04noteJ\x04\b\x02\x10\x03R\badmin_id\"Q\n" +
 	"\x13TransactionResponse\x12:\n" +
-	"\vtransaction\x18\x01 \x01(\v2\x18.transaction.TransactionR\vtransaction\"x\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x18.transaction.TransactionR\vtransaction\"~\n" +
+	"\x14CartPurchaseResponse\x12(\n" +
+	"\x05order\x18\x01 \x01(\v2\x12.transaction.OrderR\x05order\x12<\n" +
+	"\ftransactions\x18\x02 \x03(\v2\x18.transaction.TransactionR\ftransactions\"A\n" +
+	"\x0fReceiptResponse\x12.\n" +
+	"\areceipt\x18\x01 \x01(\v2\x14.transaction.ReceiptR\areceipt\"\xa0\x01\n" +
 	"\x17TransactionListResponse\x12<\n" +
 	"\ftransactions\x18\x01 \x03(\v2\x18.transaction.TransactionR\ftransactions\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"D\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"D\n" +
 	"\x0eDeleteResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x94\x02\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xda\x04\n" +
 	"\x18TransactionStatsResponse\x12-\n" +
 	"\x12total_transactions\x18\x01 \x01(\x05R\x11totalTransactions\x12!\n" +
 	"\ftotal_amount\x18\x02 \x01(\x01R\vtotalAmount\x127\n" +
 	"\x17successful_transactions\x18\x03 \x01(\x05R\x16successfulTransactions\x12/\n" +
 	"\x13failed_transactions\x18\x04 \x01(\x05R\x12failedTransactions\x12<\n" +
-	"\x1aaverage_transaction_amount\x18\x05 \x01(\x01R\x18averageTransactionAmount*J\n" +
+	"\x1aaverage_transaction_amount\x18\x05 \x01(\x01R\x18averageTransactionAmount\x12*\n" +
+	"\x11total_fee_revenue\x18\x06 \x01(\x01R\x0ftotalFeeRevenue\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x125\n" +
+	"\x16cancelled_transactions\x18\b \x01(\x05R\x15cancelledTransactions\x121\n" +
+	"\x14pending_transactions\x18\t \x01(\x05R\x13pendingTransactions\x12#\n" +
+	"\rmedian_amount\x18\n" +
+	" \x01(\x01R\fmedianAmount\x12\x1d\n" +
+	"\n" +
+	"p90_amount\x18\v \x01(\x01R\tp90Amount\x12\x1d\n" +
+	"\n" +
+	"p99_amount\x18\f \x01(\x01R\tp99Amount\x12/\n" +
+	"\x06groups\x18\r \x03(\v2\x17.transaction.StatsGroupR\x06groups\"\xc5\x02\n" +
+	"\n" +
+	"StatsGroup\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x16\n" +
+	"\x06rarity\x18\x04 \x01(\tR\x06rarity\x12-\n" +
+	"\x12total_transactions\x18\x05 \x01(\x05R\x11totalTransactions\x12!\n" +
+	"\ftotal_amount\x18\x06 \x01(\x01R\vtotalAmount\x12<\n" +
+	"\x1aaverage_transaction_amount\x18\a \x01(\x01R\x18averageTransactionAmount\x12#\n" +
+	"\rmedian_amount\x18\b \x01(\x01R\fmedianAmount\x12*\n" +
+	"\x11total_fee_revenue\x18\t \x01(\x01R\x0ftotalFeeRevenue\"\xba\x01\n" +
+	"\fVolumeBucket\x12!\n" +
+	"\fbucket_start\x18\x01 \x01(\tR\vbucketStart\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12!\n" +
+	"\ftotal_amount\x18\x03 \x01(\x01R\vtotalAmount\x12%\n" +
+	"\x0eaverage_amount\x18\x04 \x01(\x01R\raverageAmount\x12'\n" +
+	"\x0fcompletion_rate\x18\x05 \x01(\x01R\x0ecompletionRate\"r\n" +
+	"\x1fTransactionVolumeSeriesResponse\x123\n" +
+	"\abuckets\x18\x01 \x03(\v2\x19.transaction.VolumeBucketR\abuckets\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xf4\x01\n" +
+	"\vPriceCandle\x12!\n" +
+	"\fbucket_start\x18\x01 \x01(\tR\vbucketStart\x12 \n" +
+	"\x04open\x18\x02 \x01(\v2\f.money.MoneyR\x04open\x12 \n" +
+	"\x04high\x18\x03 \x01(\v2\f.money.MoneyR\x04high\x12\x1e\n" +
+	"\x03low\x18\x04 \x01(\v2\f.money.MoneyR\x03low\x12\"\n" +
+	"\x05close\x18\x05 \x01(\v2\f.money.MoneyR\x05close\x12$\n" +
+	"\x06volume\x18\x06 \x01(\v2\f.money.MoneyR\x06volume\x12\x14\n" +
+	"\x05sales\x18\a \x01(\x03R\x05sales\"\xde\x01\n" +
+	"\x18SkinPriceHistoryResponse\x12\x1b\n" +
+	"\tskin_name\x18\x01 \x01(\tR\bskinName\x12\x1c\n" +
+	"\tcondition\x18\x02 \x01(\tR\tcondition\x127\n" +
+	"\binterval\x18\x03 \x01(\x0e2\x1b.transaction.BucketIntervalR\binterval\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x122\n" +
+	"\acandles\x18\x05 \x03(\v2\x18.transaction.PriceCandleR\acandles\"\xcb\x01\n" +
+	"\x10LeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12$\n" +
+	"\x06volume\x18\x04 \x01(\v2\f.money.MoneyR\x06volume\x12\"\n" +
+	"\ftransactions\x18\x05 \x01(\x03R\ftransactions\x12$\n" +
+	"\x06profit\x18\x06 \x01(\v2\f.money.MoneyR\x06profit\"\xfd\x01\n" +
+	"\x13LeaderboardResponse\x126\n" +
+	"\x06metric\x18\x01 \x01(\x0e2\x1e.transaction.LeaderboardMetricR\x06metric\x126\n" +
+	"\x06window\x18\x02 \x01(\x0e2\x1e.transaction.LeaderboardWindowR\x06window\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x127\n" +
+	"\aentries\x18\x04 \x03(\v2\x1d.transaction.LeaderboardEntryR\aentries\x12!\n" +
+	"\fgenerated_at\x18\x05 \x01(\tR\vgeneratedAt\"\x92\x02\n" +
+	"\x10TransactionEvent\x125\n" +
+	"\x04type\x18\x01 \x01(\x0e2!.transaction.TransactionEventTypeR\x04type\x12:\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x18.transaction.TransactionR\vtransaction\x12G\n" +
+	"\x0fprevious_status\x18\x03 \x01(\x0e2\x1e.transaction.TransactionStatusR\x0epreviousStatus\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\tR\n" +
+	"occurredAt\x12!\n" +
+	"\fresume_token\x18\x05 \x01(\tR\vresumeToken\"\xdb\x05\n" +
+	"\x0eLifecycleEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\x120\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1c.transaction.TransactionTypeR\x04type\x126\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1e.transaction.TransactionStatusR\x06status\x12L\n" +
+	"\x0fprevious_status\x18\x06 \x01(\x0e2\x1e.transaction.TransactionStatusH\x00R\x0epreviousStatus\x88\x01\x01\x12\x19\n" +
+	"\bbuyer_id\x18\a \x01(\tR\abuyerId\x12\x1b\n" +
+	"\tseller_id\x18\b \x01(\tR\bsellerId\x12\x17\n" +
+	"\askin_id\x18\t \x01(\tR\x06skinId\x12$\n" +
+	"\x0ebuyer_skin_ids\x18\n" +
+	" \x03(\tR\fbuyerSkinIds\x12&\n" +
+	"\x0fseller_skin_ids\x18\v \x03(\tR\rsellerSkinIds\x12\x19\n" +
+	"\border_id\x18\f \x01(\tR\aorderId\x12$\n" +
+	"\x06amount\x18\r \x01(\v2\f.money.MoneyR\x06amount\x12+\n" +
+	"\n" +
+	"fee_amount\x18\x0e \x01(\v2\f.money.MoneyR\tfeeAmount\x125\n" +
+	"\x0fseller_proceeds\x18\x0f \x01(\v2\f.money.MoneyR\x0esellerProceeds\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\tR\tupdatedAt\x12\x1f\n" +
+	"\voccurred_at\x18\x12 \x01(\tR\n" +
+	"occurredAtB\x12\n" +
+	"\x10_previous_status\"!\n" +
+	"\vExportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"x\n" +
+	"\x1aTransactionHistoryResponse\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x123\n" +
+	"\ahistory\x18\x02 \x03(\v2\x19.transaction.StatusChangeR\ahistory\"\x8a\x01\n" +
+	"\x0fDisputeResponse\x12.\n" +
+	"\adispute\x18\x01 \x01(\v2\x14.transaction.DisputeR\adispute\x12G\n" +
+	"\x12refund_transaction\x18\x02 \x01(\v2\x18.transaction.TransactionR\x11refundTransaction\"h\n" +
+	"\x13DisputeListResponse\x120\n" +
+	"\bdisputes\x18\x01 \x03(\v2\x14.transaction.DisputeR\bdisputes\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\x99\x02\n" +
+	"\x11QuoteFeesResponse\x12\x1f\n" +
+	"\vfee_percent\x18\x02 \x01(\x01R\n" +
+	"feePercent\x12\x16\n" +
+	"\x06exempt\x18\x06 \x01(\bR\x06exempt\x12$\n" +
+	"\x06amount\x18\a \x01(\v2\f.money.MoneyR\x06amount\x12)\n" +
+	"\tfixed_fee\x18\b \x01(\v2\f.money.MoneyR\bfixedFee\x12+\n" +
+	"\n" +
+	"fee_amount\x18\t \x01(\v2\f.money.MoneyR\tfeeAmount\x125\n" +
+	"\x0fseller_proceeds\x18\n" +
+	" \x01(\v2\f.money.MoneyR\x0esellerProceedsJ\x04\b\x01\x10\x02J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06*J\n" +
 	"\x11TransactionStatus\x12\v\n" +
 	"\aPENDING\x10\x00\x12\r\n" +
 	"\tCOMPLETED\x10\x01\x12\n" +
 	"\n" +
 	"\x06FAILED\x10\x02\x12\r\n" +
-	"\tCANCELLED\x10\x03*$\n" +
+	"\tCANCELLED\x10\x03*;\n" +
 	"\x0fTransactionType\x12\a\n" +
 	"\x03BUY\x10\x00\x12\b\n" +
-	"\x04SELL\x10\x012\xad\t\n" +
+	"\x04SELL\x10\x01\x12\n" +
+	"\n" +
+	"\x06REFUND\x10\x02\x12\t\n" +
+	"\x05TRADE\x10\x03*M\n" +
+	"\rDisputeStatus\x12\x10\n" +
+	"\fDISPUTE_OPEN\x10\x00\x12\x14\n" +
+	"\x10DISPUTE_REFUNDED\x10\x01\x12\x14\n" +
+	"\x10DISPUTE_REJECTED\x10\x02*?\n" +
+	"\fRiskDecision\x12\x0e\n" +
+	"\n" +
+	"RISK_ALLOW\x10\x00\x12\x0f\n" +
+	"\vRISK_REVIEW\x10\x01\x12\x0e\n" +
+	"\n" +
+	"RISK_BLOCK\x10\x02*G\n" +
+	"\vOrderStatus\x12\x11\n" +
+	"\rORDER_PENDING\x10\x00\x12\x13\n" +
+	"\x0fORDER_COMPLETED\x10\x01\x12\x10\n" +
+	"\fORDER_FAILED\x10\x02*\x81\x01\n" +
+	"\x14TransactionEventType\x12\x17\n" +
+	"\x13TRANSACTION_CREATED\x10\x00\x12\x17\n" +
+	"\x13TRANSACTION_UPDATED\x10\x01\x12\x1e\n" +
+	"\x1aTRANSACTION_STATUS_CHANGED\x10\x02\x12\x17\n" +
+	"\x13TRANSACTION_DELETED\x10\x03*'\n" +
+	"\fExportFormat\x12\a\n" +
+	"\x03CSV\x10\x00\x12\x0e\n" +
+	"\n" +
+	"JSON_LINES\x10\x01*`\n" +
+	"\x0eStatsDimension\x12\x11\n" +
+	"\rSTATS_BY_TYPE\x10\x00\x12\x13\n" +
+	"\x0fSTATS_BY_STATUS\x10\x01\x12\x11\n" +
+	"\rSTATS_BY_ROLE\x10\x02\x12\x13\n" +
+	"\x0fSTATS_BY_RARITY\x10\x03*a\n" +
+	"\x11LeaderboardMetric\x12\x16\n" +
+	"\x12LEADERBOARD_VOLUME\x10\x00\x12\x1c\n" +
+	"\x18LEADERBOARD_TRANSACTIONS\x10\x01\x12\x16\n" +
+	"\x12LEADERBOARD_PROFIT\x10\x02*[\n" +
+	"\x11LeaderboardWindow\x12\x0e\n" +
+	"\n" +
+	"WINDOW_DAY\x10\x00\x12\x0f\n" +
+	"\vWINDOW_WEEK\x10\x01\x12\x10\n" +
+	"\fWINDOW_MONTH\x10\x02\x12\x13\n" +
+	"\x0fWINDOW_ALL_TIME\x10\x03*8\n" +
+	"\x0eBucketInterval\x12\a\n" +
+	"\x03DAY\x10\x00\x12\b\n" +
+	"\x04HOUR\x10\x01\x12\b\n" +
+	"\x04WEEK\x10\x02\x12\t\n" +
+	"\x05MONTH\x10\x032\xc6\x14\n" +
 	"\x12TransactionService\x12\\\n" +
 	"\x11CreateTransaction\x12%.transaction.CreateTransactionRequest\x1a .transaction.TransactionResponse\x12V\n" +
-	"\x0eGetTransaction\x12\".transaction.GetTransactionRequest\x1a .transaction.TransactionResponse\x12\\\n" +
+	"\x0eGetTransaction\x12\".transaction.GetTransactionRequest\x1a .transaction.TransactionResponse\x12d\n" +
+	"\x15GetTransactionHistory\x12\".transaction.GetTransactionRequest\x1a'.transaction.TransactionHistoryResponse\x12J\n" +
+	"\n" +
+	"GetReceipt\x12\x1e.transaction.GetReceiptRequest\x1a\x1c.transaction.ReceiptResponse\x12\\\n" +
 	"\x11UpdateTransaction\x12%.transaction.UpdateTransactionRequest\x1a .transaction.TransactionResponse\x12T\n" +
-	"\x11DeleteTransaction\x12\".transaction.GetTransactionRequest\x1a\x1b.transaction.DeleteResponse\x12c\n" +
+	"\x11DeleteTransaction\x12\".transaction.GetTransactionRequest\x1a\x1b.transaction.DeleteResponse\x12^\n" +
+	"\x12RestoreTransaction\x12&.transaction.RestoreTransactionRequest\x1a .transaction.TransactionResponse\x12c\n" +
 	"\x10ListTransactions\x12).transaction.GetTransactionsByUserRequest\x1a$.transaction.TransactionListResponse\x12h\n" +
 	"\x15GetTransactionsByUser\x12).transaction.GetTransactionsByUserRequest\x1a$.transaction.TransactionListResponse\x12h\n" +
 	"\x15GetTransactionsBySkin\x12).transaction.GetTransactionsBySkinRequest\x1a$.transaction.TransactionListResponse\x12l\n" +
 	"\x17GetTransactionsByStatus\x12+.transaction.GetTransactionsByStatusRequest\x1a$.transaction.TransactionListResponse\x12X\n" +
-	"\x0fProcessPurchase\x12#.transaction.ProcessPurchaseRequest\x1a .transaction.TransactionResponse\x12\\\n" +
-	"\x11CancelTransaction\x12%.transaction.CancelTransactionRequest\x1a .transaction.TransactionResponse\x12e\n" +
-	"\x13GetTransactionStats\x12'.transaction.GetTransactionStatsRequest\x1a%.transaction.TransactionStatsResponse\x12g\n" +
-	"\x12GetAllTransactions\x12+.transaction.GetTransactionsByStatusRequest\x1a$.transaction.TransactionListResponseB1Z/cs2-marketplace-microservices/proto/transactionb\x06proto3"
+	"\x0fProcessPurchase\x12#.transaction.ProcessPurchaseRequest\x1a .transaction.TransactionResponse\x12a\n" +
+	"\x13ProcessCartPurchase\x12'.transaction.ProcessCartPurchaseRequest\x1a!.transaction.CartPurchaseResponse\x12\\\n" +
+	"\x11CancelTransaction\x12%.transaction.CancelTransactionRequest\x1a .transaction.TransactionResponse\x12P\n" +
+	"\vCreateTrade\x12\x1f.transaction.CreateTradeRequest\x1a .transaction.TransactionResponse\x12P\n" +
+	"\vAcceptTrade\x12\x1f.transaction.AcceptTradeRequest\x1a .transaction.TransactionResponse\x12J\n" +
+	"\tQuoteFees\x12\x1d.transaction.QuoteFeesRequest\x1a\x1e.transaction.QuoteFeesResponse\x12[\n" +
+	"\x11WatchTransactions\x12%.transaction.WatchTransactionsRequest\x1a\x1d.transaction.TransactionEvent0\x01\x12L\n" +
+	"\vOpenDispute\x12\x1f.transaction.OpenDisputeRequest\x1a\x1c.transaction.DisputeResponse\x12J\n" +
+	"\n" +
+	"GetDispute\x12\x1e.transaction.GetDisputeRequest\x1a\x1c.transaction.DisputeResponse\x12R\n" +
+	"\fListDisputes\x12 .transaction.ListDisputesRequest\x1a .transaction.DisputeListResponse\x12R\n" +
+	"\x0eResolveDispute\x12\".transaction.ResolveDisputeRequest\x1a\x1c.transaction.DisputeResponse\x12e\n" +
+	"\x13GetTransactionStats\x12'.transaction.GetTransactionStatsRequest\x1a%.transaction.TransactionStatsResponse\x12z\n" +
+	"\x1aGetTransactionVolumeSeries\x12..transaction.GetTransactionVolumeSeriesRequest\x1a,.transaction.TransactionVolumeSeriesResponse\x12e\n" +
+	"\x13GetSkinPriceHistory\x12'.transaction.GetSkinPriceHistoryRequest\x1a%.transaction.SkinPriceHistoryResponse\x12V\n" +
+	"\x0eGetLeaderboard\x12\".transaction.GetLeaderboardRequest\x1a .transaction.LeaderboardResponse\x12g\n" +
+	"\x12GetAllTransactions\x12+.transaction.GetTransactionsByStatusRequest\x1a$.transaction.TransactionListResponse\x12X\n" +
+	"\x12ExportTransactions\x12&.transaction.ExportTransactionsRequest\x1a\x18.transaction.ExportChunk0\x01B1Z/cs2-marketplace-microservices/proto/transactionb\x06proto3"
 
 var (
 	file_shared_proto_transaction_proto_rawDescOnce sync.Once
@@ -1086,65 +5277,218 @@ func file_shared_proto_transaction_proto_rawDescGZIP() []byte {
 	return file_shared_proto_transaction_proto_rawDescData
 }
 
-var file_shared_proto_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_shared_proto_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_shared_proto_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_shared_proto_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_shared_proto_transaction_proto_goTypes = []any{
-	(TransactionStatus)(0),                 // 0: transaction.TransactionStatus
-	(TransactionType)(0),                   // 1: transaction.TransactionType
-	(*Transaction)(nil),                    // 2: transaction.Transaction
-	(*CreateTransactionRequest)(nil),       // 3: transaction.CreateTransactionRequest
-	(*GetTransactionRequest)(nil),          // 4: transaction.GetTransactionRequest
-	(*UpdateTransactionRequest)(nil),       // 5: transaction.UpdateTransactionRequest
-	(*GetTransactionsByUserRequest)(nil),   // 6: transaction.GetTransactionsByUserRequest
-	(*GetTransactionsBySkinRequest)(nil),   // 7: transaction.GetTransactionsBySkinRequest
-	(*GetTransactionsByStatusRequest)(nil), // 8: transaction.GetTransactionsByStatusRequest
-	(*ProcessPurchaseRequest)(nil),         // 9: transaction.ProcessPurchaseRequest
-	(*CancelTransactionRequest)(nil),       // 10: transaction.CancelTransactionRequest
-	(*GetTransactionStatsRequest)(nil),     // 11: transaction.GetTransactionStatsRequest
-	(*TransactionResponse)(nil),            // 12: transaction.TransactionResponse
-	(*TransactionListResponse)(nil),        // 13: transaction.TransactionListResponse
-	(*DeleteResponse)(nil),                 // 14: transaction.DeleteResponse
-	(*TransactionStatsResponse)(nil),       // 15: transaction.TransactionStatsResponse
+	(TransactionStatus)(0),                    // 0: transaction.TransactionStatus
+	(TransactionType)(0),                      // 1: transaction.TransactionType
+	(DisputeStatus)(0),                        // 2: transaction.DisputeStatus
+	(RiskDecision)(0),                         // 3: transaction.RiskDecision
+	(OrderStatus)(0),                          // 4: transaction.OrderStatus
+	(TransactionEventType)(0),                 // 5: transaction.TransactionEventType
+	(ExportFormat)(0),                         // 6: transaction.ExportFormat
+	(StatsDimension)(0),                       // 7: transaction.StatsDimension
+	(LeaderboardMetric)(0),                    // 8: transaction.LeaderboardMetric
+	(LeaderboardWindow)(0),                    // 9: transaction.LeaderboardWindow
+	(BucketInterval)(0),                       // 10: transaction.BucketInterval
+	(*Transaction)(nil),                       // 11: transaction.Transaction
+	(*RiskAssessment)(nil),                    // 12: transaction.RiskAssessment
+	(*RiskFinding)(nil),                       // 13: transaction.RiskFinding
+	(*StatusChange)(nil),                      // 14: transaction.StatusChange
+	(*Order)(nil),                             // 15: transaction.Order
+	(*OrderItem)(nil),                         // 16: transaction.OrderItem
+	(*Receipt)(nil),                           // 17: transaction.Receipt
+	(*ReceiptItem)(nil),                       // 18: transaction.ReceiptItem
+	(*Dispute)(nil),                           // 19: transaction.Dispute
+	(*CreateTransactionRequest)(nil),          // 20: transaction.CreateTransactionRequest
+	(*CreateTradeRequest)(nil),                // 21: transaction.CreateTradeRequest
+	(*AcceptTradeRequest)(nil),                // 22: transaction.AcceptTradeRequest
+	(*GetTransactionRequest)(nil),             // 23: transaction.GetTransactionRequest
+	(*UpdateTransactionRequest)(nil),          // 24: transaction.UpdateTransactionRequest
+	(*GetTransactionsByUserRequest)(nil),      // 25: transaction.GetTransactionsByUserRequest
+	(*GetTransactionsBySkinRequest)(nil),      // 26: transaction.GetTransactionsBySkinRequest
+	(*GetTransactionsByStatusRequest)(nil),    // 27: transaction.GetTransactionsByStatusRequest
+	(*ProcessPurchaseRequest)(nil),            // 28: transaction.ProcessPurchaseRequest
+	(*ProcessCartPurchaseRequest)(nil),        // 29: transaction.ProcessCartPurchaseRequest
+	(*GetReceiptRequest)(nil),                 // 30: transaction.GetReceiptRequest
+	(*QuoteFeesRequest)(nil),                  // 31: transaction.QuoteFeesRequest
+	(*RestoreTransactionRequest)(nil),         // 32: transaction.RestoreTransactionRequest
+	(*CancelTransactionRequest)(nil),          // 33: transaction.CancelTransactionRequest
+	(*GetTransactionStatsRequest)(nil),        // 34: transaction.GetTransactionStatsRequest
+	(*GetTransactionVolumeSeriesRequest)(nil), // 35: transaction.GetTransactionVolumeSeriesRequest
+	(*GetSkinPriceHistoryRequest)(nil),        // 36: transaction.GetSkinPriceHistoryRequest
+	(*GetLeaderboardRequest)(nil),             // 37: transaction.GetLeaderboardRequest
+	(*WatchTransactionsRequest)(nil),          // 38: transaction.WatchTransactionsRequest
+	(*ExportTransactionsRequest)(nil),         // 39: transaction.ExportTransactionsRequest
+	(*OpenDisputeRequest)(nil),                // 40: transaction.OpenDisputeRequest
+	(*GetDisputeRequest)(nil),                 // 41: transaction.GetDisputeRequest
+	(*ListDisputesRequest)(nil),               // 42: transaction.ListDisputesRequest
+	(*ResolveDisputeRequest)(nil),             // 43: transaction.ResolveDisputeRequest
+	(*TransactionResponse)(nil),               // 44: transaction.TransactionResponse
+	(*CartPurchaseResponse)(nil),              // 45: transaction.CartPurchaseResponse
+	(*ReceiptResponse)(nil),                   // 46: transaction.ReceiptResponse
+	(*TransactionListResponse)(nil),           // 47: transaction.TransactionListResponse
+	(*DeleteResponse)(nil),                    // 48: transaction.DeleteResponse
+	(*TransactionStatsResponse)(nil),          // 49: transaction.TransactionStatsResponse
+	(*StatsGroup)(nil),                        // 50: transaction.StatsGroup
+	(*VolumeBucket)(nil),                      // 51: transaction.VolumeBucket
+	(*TransactionVolumeSeriesResponse)(nil),   // 52: transaction.TransactionVolumeSeriesResponse
+	(*PriceCandle)(nil),                       // 53: transaction.PriceCandle
+	(*SkinPriceHistoryResponse)(nil),          // 54: transaction.SkinPriceHistoryResponse
+	(*LeaderboardEntry)(nil),                  // 55: transaction.LeaderboardEntry
+	(*LeaderboardResponse)(nil),               // 56: transaction.LeaderboardResponse
+	(*TransactionEvent)(nil),                  // 57: transaction.TransactionEvent
+	(*LifecycleEvent)(nil),                    // 58: transaction.LifecycleEvent
+	(*ExportChunk)(nil),                       // 59: transaction.ExportChunk
+	(*TransactionHistoryResponse)(nil),        // 60: transaction.TransactionHistoryResponse
+	(*DisputeResponse)(nil),                   // 61: transaction.DisputeResponse
+	(*DisputeListResponse)(nil),               // 62: transaction.DisputeListResponse
+	(*QuoteFeesResponse)(nil),                 // 63: transaction.QuoteFeesResponse
+	(*money.Money)(nil),                       // 64: money.Money
+	(*money.Conversion)(nil),                  // 65: money.Conversion
 }
 var file_shared_proto_transaction_proto_depIdxs = []int32{
-	0,  // 0: transaction.Transaction.status:type_name -> transaction.TransactionStatus
-	1,  // 1: transaction.Transaction.type:type_name -> transaction.TransactionType
-	1,  // 2: transaction.CreateTransactionRequest.type:type_name -> transaction.TransactionType
-	0,  // 3: transaction.UpdateTransactionRequest.status:type_name -> transaction.TransactionStatus
-	0,  // 4: transaction.GetTransactionsByUserRequest.status:type_name -> transaction.TransactionStatus
-	1,  // 5: transaction.GetTransactionsByUserRequest.type:type_name -> transaction.TransactionType
-	0,  // 6: transaction.GetTransactionsByStatusRequest.status:type_name -> transaction.TransactionStatus
-	2,  // 7: transaction.TransactionResponse.transaction:type_name -> transaction.Transaction
-	2,  // 8: transaction.TransactionListResponse.transactions:type_name -> transaction.Transaction
-	3,  // 9: transaction.TransactionService.CreateTransaction:input_type -> transaction.CreateTransactionRequest
-	4,  // 10: transaction.TransactionService.GetTransaction:input_type -> transaction.GetTransactionRequest
-	5,  // 11: transaction.TransactionService.UpdateTransaction:input_type -> transaction.UpdateTransactionRequest
-	4,  // 12: transaction.TransactionService.DeleteTransaction:input_type -> transaction.GetTransactionRequest
-	6,  // 13: transaction.TransactionService.ListTransactions:input_type -> transaction.GetTransactionsByUserRequest
-	6,  // 14: transaction.TransactionService.GetTransactionsByUser:input_type -> transaction.GetTransactionsByUserRequest
-	7,  // 15: transaction.TransactionService.GetTransactionsBySkin:input_type -> transaction.GetTransactionsBySkinRequest
-	8,  // 16: transaction.TransactionService.GetTransactionsByStatus:input_type -> transaction.GetTransactionsByStatusRequest
-	9,  // 17: transaction.TransactionService.ProcessPurchase:input_type -> transaction.ProcessPurchaseRequest
-	10, // 18: transaction.TransactionService.CancelTransaction:input_type -> transaction.CancelTransactionRequest
-	11, // 19: transaction.TransactionService.GetTransactionStats:input_type -> transaction.GetTransactionStatsRequest
-	8,  // 20: transaction.TransactionService.GetAllTransactions:input_type -> transaction.GetTransactionsByStatusRequest
-	12, // 21: transaction.TransactionService.CreateTransaction:output_type -> transaction.TransactionResponse
-	12, // 22: transaction.TransactionService.GetTransaction:output_type -> transaction.TransactionResponse
-	12, // 23: transaction.TransactionService.UpdateTransaction:output_type -> transaction.TransactionResponse
-	14, // 24: transaction.TransactionService.DeleteTransaction:output_type -> transaction.DeleteResponse
-	13, // 25: transaction.TransactionService.ListTransactions:output_type -> transaction.TransactionListResponse
-	13, // 26: transaction.TransactionService.GetTransactionsByUser:output_type -> transaction.TransactionListResponse
-	13, // 27: transaction.TransactionService.GetTransactionsBySkin:output_type -> transaction.TransactionListResponse
-	13, // 28: transaction.TransactionService.GetTransactionsByStatus:output_type -> transaction.TransactionListResponse
-	12, // 29: transaction.TransactionService.ProcessPurchase:output_type -> transaction.TransactionResponse
-	12, // 30: transaction.TransactionService.CancelTransaction:output_type -> transaction.TransactionResponse
-	15, // 31: transaction.TransactionService.GetTransactionStats:output_type -> transaction.TransactionStatsResponse
-	13, // 32: transaction.TransactionService.GetAllTransactions:output_type -> transaction.TransactionListResponse
-	21, // [21:33] is the sub-list for method output_type
-	9,  // [9:21] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	0,   // 0: transaction.Transaction.status:type_name -> transaction.TransactionStatus
+	1,   // 1: transaction.Transaction.type:type_name -> transaction.TransactionType
+	64,  // 2: transaction.Transaction.amount:type_name -> money.Money
+	64,  // 3: transaction.Transaction.fee_amount:type_name -> money.Money
+	64,  // 4: transaction.Transaction.seller_proceeds:type_name -> money.Money
+	65,  // 5: transaction.Transaction.conversions:type_name -> money.Conversion
+	12,  // 6: transaction.Transaction.risk:type_name -> transaction.RiskAssessment
+	3,   // 7: transaction.RiskAssessment.decision:type_name -> transaction.RiskDecision
+	13,  // 8: transaction.RiskAssessment.findings:type_name -> transaction.RiskFinding
+	3,   // 9: transaction.RiskFinding.decision:type_name -> transaction.RiskDecision
+	0,   // 10: transaction.StatusChange.from_status:type_name -> transaction.TransactionStatus
+	0,   // 11: transaction.StatusChange.to_status:type_name -> transaction.TransactionStatus
+	4,   // 12: transaction.Order.status:type_name -> transaction.OrderStatus
+	64,  // 13: transaction.Order.total:type_name -> money.Money
+	16,  // 14: transaction.Order.items:type_name -> transaction.OrderItem
+	64,  // 15: transaction.OrderItem.price:type_name -> money.Money
+	18,  // 16: transaction.Receipt.items:type_name -> transaction.ReceiptItem
+	64,  // 17: transaction.Receipt.subtotal:type_name -> money.Money
+	64,  // 18: transaction.Receipt.fee_amount:type_name -> money.Money
+	64,  // 19: transaction.Receipt.seller_proceeds:type_name -> money.Money
+	64,  // 20: transaction.Receipt.total:type_name -> money.Money
+	64,  // 21: transaction.ReceiptItem.price:type_name -> money.Money
+	2,   // 22: transaction.Dispute.status:type_name -> transaction.DisputeStatus
+	1,   // 23: transaction.CreateTransactionRequest.type:type_name -> transaction.TransactionType
+	64,  // 24: transaction.CreateTransactionRequest.amount:type_name -> money.Money
+	65,  // 25: transaction.CreateTransactionRequest.conversions:type_name -> money.Conversion
+	64,  // 26: transaction.CreateTradeRequest.top_up:type_name -> money.Money
+	65,  // 27: transaction.CreateTradeRequest.conversions:type_name -> money.Conversion
+	0,   // 28: transaction.UpdateTransactionRequest.status:type_name -> transaction.TransactionStatus
+	0,   // 29: transaction.GetTransactionsByUserRequest.status:type_name -> transaction.TransactionStatus
+	1,   // 30: transaction.GetTransactionsByUserRequest.type:type_name -> transaction.TransactionType
+	0,   // 31: transaction.GetTransactionsByStatusRequest.status:type_name -> transaction.TransactionStatus
+	65,  // 32: transaction.ProcessPurchaseRequest.conversions:type_name -> money.Conversion
+	65,  // 33: transaction.ProcessCartPurchaseRequest.conversions:type_name -> money.Conversion
+	64,  // 34: transaction.QuoteFeesRequest.amount:type_name -> money.Money
+	65,  // 35: transaction.QuoteFeesRequest.conversions:type_name -> money.Conversion
+	7,   // 36: transaction.GetTransactionStatsRequest.group_by:type_name -> transaction.StatsDimension
+	10,  // 37: transaction.GetTransactionVolumeSeriesRequest.interval:type_name -> transaction.BucketInterval
+	0,   // 38: transaction.GetTransactionVolumeSeriesRequest.status:type_name -> transaction.TransactionStatus
+	10,  // 39: transaction.GetSkinPriceHistoryRequest.interval:type_name -> transaction.BucketInterval
+	8,   // 40: transaction.GetLeaderboardRequest.metric:type_name -> transaction.LeaderboardMetric
+	9,   // 41: transaction.GetLeaderboardRequest.window:type_name -> transaction.LeaderboardWindow
+	6,   // 42: transaction.ExportTransactionsRequest.format:type_name -> transaction.ExportFormat
+	2,   // 43: transaction.ListDisputesRequest.status:type_name -> transaction.DisputeStatus
+	11,  // 44: transaction.TransactionResponse.transaction:type_name -> transaction.Transaction
+	15,  // 45: transaction.CartPurchaseResponse.order:type_name -> transaction.Order
+	11,  // 46: transaction.CartPurchaseResponse.transactions:type_name -> transaction.Transaction
+	17,  // 47: transaction.ReceiptResponse.receipt:type_name -> transaction.Receipt
+	11,  // 48: transaction.TransactionListResponse.transactions:type_name -> transaction.Transaction
+	50,  // 49: transaction.TransactionStatsResponse.groups:type_name -> transaction.StatsGroup
+	51,  // 50: transaction.TransactionVolumeSeriesResponse.buckets:type_name -> transaction.VolumeBucket
+	64,  // 51: transaction.PriceCandle.open:type_name -> money.Money
+	64,  // 52: transaction.PriceCandle.high:type_name -> money.Money
+	64,  // 53: transaction.PriceCandle.low:type_name -> money.Money
+	64,  // 54: transaction.PriceCandle.close:type_name -> money.Money
+	64,  // 55: transaction.PriceCandle.volume:type_name -> money.Money
+	10,  // 56: transaction.SkinPriceHistoryResponse.interval:type_name -> transaction.BucketInterval
+	53,  // 57: transaction.SkinPriceHistoryResponse.candles:type_name -> transaction.PriceCandle
+	64,  // 58: transaction.LeaderboardEntry.volume:type_name -> money.Money
+	64,  // 59: transaction.LeaderboardEntry.profit:type_name -> money.Money
+	8,   // 60: transaction.LeaderboardResponse.metric:type_name -> transaction.LeaderboardMetric
+	9,   // 61: transaction.LeaderboardResponse.window:type_name -> transaction.LeaderboardWindow
+	55,  // 62: transaction.LeaderboardResponse.entries:type_name -> transaction.LeaderboardEntry
+	5,   // 63: transaction.TransactionEvent.type:type_name -> transaction.TransactionEventType
+	11,  // 64: transaction.TransactionEvent.transaction:type_name -> transaction.Transaction
+	0,   // 65: transaction.TransactionEvent.previous_status:type_name -> transaction.TransactionStatus
+	1,   // 66: transaction.LifecycleEvent.type:type_name -> transaction.TransactionType
+	0,   // 67: transaction.LifecycleEvent.status:type_name -> transaction.TransactionStatus
+	0,   // 68: transaction.LifecycleEvent.previous_status:type_name -> transaction.TransactionStatus
+	64,  // 69: transaction.LifecycleEvent.amount:type_name -> money.Money
+	64,  // 70: transaction.LifecycleEvent.fee_amount:type_name -> money.Money
+	64,  // 71: transaction.LifecycleEvent.seller_proceeds:type_name -> money.Money
+	14,  // 72: transaction.TransactionHistoryResponse.history:type_name -> transaction.StatusChange
+	19,  // 73: transaction.DisputeResponse.dispute:type_name -> transaction.Dispute
+	11,  // 74: transaction.DisputeResponse.refund_transaction:type_name -> transaction.Transaction
+	19,  // 75: transaction.DisputeListResponse.disputes:type_name -> transaction.Dispute
+	64,  // 76: transaction.QuoteFeesResponse.amount:type_name -> money.Money
+	64,  // 77: transaction.QuoteFeesResponse.fixed_fee:type_name -> money.Money
+	64,  // 78: transaction.QuoteFeesResponse.fee_amount:type_name -> money.Money
+	64,  // 79: transaction.QuoteFeesResponse.seller_proceeds:type_name -> money.Money
+	20,  // 80: transaction.TransactionService.CreateTransaction:input_type -> transaction.CreateTransactionRequest
+	23,  // 81: transaction.TransactionService.GetTransaction:input_type -> transaction.GetTransactionRequest
+	23,  // 82: transaction.TransactionService.GetTransactionHistory:input_type -> transaction.GetTransactionRequest
+	30,  // 83: transaction.TransactionService.GetReceipt:input_type -> transaction.GetReceiptRequest
+	24,  // 84: transaction.TransactionService.UpdateTransaction:input_type -> transaction.UpdateTransactionRequest
+	23,  // 85: transaction.TransactionService.DeleteTransaction:input_type -> transaction.GetTransactionRequest
+	32,  // 86: transaction.TransactionService.RestoreTransaction:input_type -> transaction.RestoreTransactionRequest
+	25,  // 87: transaction.TransactionService.ListTransactions:input_type -> transaction.GetTransactionsByUserRequest
+	25,  // 88: transaction.TransactionService.GetTransactionsByUser:input_type -> transaction.GetTransactionsByUserRequest
+	26,  // 89: transaction.TransactionService.GetTransactionsBySkin:input_type -> transaction.GetTransactionsBySkinRequest
+	27,  // 90: transaction.TransactionService.GetTransactionsByStatus:input_type -> transaction.GetTransactionsByStatusRequest
+	28,  // 91: transaction.TransactionService.ProcessPurchase:input_type -> transaction.ProcessPurchaseRequest
+	29,  // 92: transaction.TransactionService.ProcessCartPurchase:input_type -> transaction.ProcessCartPurchaseRequest
+	33,  // 93: transaction.TransactionService.CancelTransaction:input_type -> transaction.CancelTransactionRequest
+	21,  // 94: transaction.TransactionService.CreateTrade:input_type -> transaction.CreateTradeRequest
+	22,  // 95: transaction.TransactionService.AcceptTrade:input_type -> transaction.AcceptTradeRequest
+	31,  // 96: transaction.TransactionService.QuoteFees:input_type -> transaction.QuoteFeesRequest
+	38,  // 97: transaction.TransactionService.WatchTransactions:input_type -> transaction.WatchTransactionsRequest
+	40,  // 98: transaction.TransactionService.OpenDispute:input_type -> transaction.OpenDisputeRequest
+	41,  // 99: transaction.TransactionService.GetDispute:input_type -> transaction.GetDisputeRequest
+	42,  // 100: transaction.TransactionService.ListDisputes:input_type -> transaction.ListDisputesRequest
+	43,  // 101: transaction.TransactionService.ResolveDispute:input_type -> transaction.ResolveDisputeRequest
+	34,  // 102: transaction.TransactionService.GetTransactionStats:input_type -> transaction.GetTransactionStatsRequest
+	35,  // 103: transaction.TransactionService.GetTransactionVolumeSeries:input_type -> transaction.GetTransactionVolumeSeriesRequest
+	36,  // 104: transaction.TransactionService.GetSkinPriceHistory:input_type -> transaction.GetSkinPriceHistoryRequest
+	37,  // 105: transaction.TransactionService.GetLeaderboard:input_type -> transaction.GetLeaderboardRequest
+	27,  // 106: transaction.TransactionService.GetAllTransactions:input_type -> transaction.GetTransactionsByStatusRequest
+	39,  // 107: transaction.TransactionService.ExportTransactions:input_type -> transaction.ExportTransactionsRequest
+	44,  // 108: transaction.TransactionService.CreateTransaction:output_type -> transaction.TransactionResponse
+	44,  // 109: transaction.TransactionService.GetTransaction:output_type -> transaction.TransactionResponse
+	60,  // 110: transaction.TransactionService.GetTransactionHistory:output_type -> transaction.TransactionHistoryResponse
+	46,  // 111: transaction.TransactionService.GetReceipt:output_type -> transaction.ReceiptResponse
+	44,  // 112: transaction.TransactionService.UpdateTransaction:output_type -> transaction.TransactionResponse
+	48,  // 113: transaction.TransactionService.DeleteTransaction:output_type -> transaction.DeleteResponse
+	44,  // 114: transaction.TransactionService.RestoreTransaction:output_type -> transaction.TransactionResponse
+	47,  // 115: transaction.TransactionService.ListTransactions:output_type -> transaction.TransactionListResponse
+	47,  // 116: transaction.TransactionService.GetTransactionsByUser:output_type -> transaction.TransactionListResponse
+	47,  // 117: transaction.TransactionService.GetTransactionsBySkin:output_type -> transaction.TransactionListResponse
+	47,  // 118: transaction.TransactionService.GetTransactionsByStatus:output_type -> transaction.TransactionListResponse
+	44,  // 119: transaction.TransactionService.ProcessPurchase:output_type -> transaction.TransactionResponse
+	45,  // 120: transaction.TransactionService.ProcessCartPurchase:output_type -> transaction.CartPurchaseResponse
+	44,  // 121: transaction.TransactionService.CancelTransaction:output_type -> transaction.TransactionResponse
+	44,  // 122: transaction.TransactionService.CreateTrade:output_type -> transaction.TransactionResponse
+	44,  // 123: transaction.TransactionService.AcceptTrade:output_type -> transaction.TransactionResponse
+	63,  // 124: transaction.TransactionService.QuoteFees:output_type -> transaction.QuoteFeesResponse
+	57,  // 125: transaction.TransactionService.WatchTransactions:output_type -> transaction.TransactionEvent
+	61,  // 126: transaction.TransactionService.OpenDispute:output_type -> transaction.DisputeResponse
+	61,  // 127: transaction.TransactionService.GetDispute:output_type -> transaction.DisputeResponse
+	62,  // 128: transaction.TransactionService.ListDisputes:output_type -> transaction.DisputeListResponse
+	61,  // 129: transaction.TransactionService.ResolveDispute:output_type -> transaction.DisputeResponse
+	49,  // 130: transaction.TransactionService.GetTransactionStats:output_type -> transaction.TransactionStatsResponse
+	52,  // 131: transaction.TransactionService.GetTransactionVolumeSeries:output_type -> transaction.TransactionVolumeSeriesResponse
+	54,  // 132: transaction.TransactionService.GetSkinPriceHistory:output_type -> transaction.SkinPriceHistoryResponse
+	56,  // 133: transaction.TransactionService.GetLeaderboard:output_type -> transaction.LeaderboardResponse
+	47,  // 134: transaction.TransactionService.GetAllTransactions:output_type -> transaction.TransactionListResponse
+	59,  // 135: transaction.TransactionService.ExportTransactions:output_type -> transaction.ExportChunk
+	108, // [108:136] is the sub-list for method output_type
+	80,  // [80:108] is the sub-list for method input_type
+	80,  // [80:80] is the sub-list for extension type_name
+	80,  // [80:80] is the sub-list for extension extendee
+	0,   // [0:80] is the sub-list for field type_name
 }
 
 func init() { file_shared_proto_transaction_proto_init() }
@@ -1152,13 +5496,19 @@ func file_shared_proto_transaction_proto_init() {
 	if File_shared_proto_transaction_proto != nil {
 		return
 	}
+	file_shared_proto_transaction_proto_msgTypes[3].OneofWrappers = []any{}
+	file_shared_proto_transaction_proto_msgTypes[13].OneofWrappers = []any{}
+	file_shared_proto_transaction_proto_msgTypes[14].OneofWrappers = []any{}
+	file_shared_proto_transaction_proto_msgTypes[24].OneofWrappers = []any{}
+	file_shared_proto_transaction_proto_msgTypes[31].OneofWrappers = []any{}
+	file_shared_proto_transaction_proto_msgTypes[47].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shared_proto_transaction_proto_rawDesc), len(file_shared_proto_transaction_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   14,
+			NumEnums:      11,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,18 +19,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TransactionService_CreateTransaction_FullMethodName       = "/transaction.TransactionService/CreateTransaction"
-	TransactionService_GetTransaction_FullMethodName          = "/transaction.TransactionService/GetTransaction"
-	TransactionService_UpdateTransaction_FullMethodName       = "/transaction.TransactionService/UpdateTransaction"
-	TransactionService_DeleteTransaction_FullMethodName       = "/transaction.TransactionService/DeleteTransaction"
-	TransactionService_ListTransactions_FullMethodName        = "/transaction.TransactionService/ListTransactions"
-	TransactionService_GetTransactionsByUser_FullMethodName   = "/transaction.TransactionService/GetTransactionsByUser"
-	TransactionService_GetTransactionsBySkin_FullMethodName   = "/transaction.TransactionService/GetTransactionsBySkin"
-	TransactionService_GetTransactionsByStatus_FullMethodName = "/transaction.TransactionService/GetTransactionsByStatus"
-	TransactionService_ProcessPurchase_FullMethodName         = "/transaction.TransactionService/ProcessPurchase"
-	TransactionService_CancelTransaction_FullMethodName       = "/transaction.TransactionService/CancelTransaction"
-	TransactionService_GetTransactionStats_FullMethodName     = "/transaction.TransactionService/GetTransactionStats"
-	TransactionService_GetAllTransactions_FullMethodName      = "/transaction.TransactionService/GetAllTransactions"
+	TransactionService_CreateTransaction_FullMethodName          = "/transaction.TransactionService/CreateTransaction"
+	TransactionService_GetTransaction_FullMethodName             = "/transaction.TransactionService/GetTransaction"
+	TransactionService_GetTransactionHistory_FullMethodName      = "/transaction.TransactionService/GetTransactionHistory"
+	TransactionService_GetReceipt_FullMethodName                 = "/transaction.TransactionService/GetReceipt"
+	TransactionService_UpdateTransaction_FullMethodName          = "/transaction.TransactionService/UpdateTransaction"
+	TransactionService_DeleteTransaction_FullMethodName          = "/transaction.TransactionService/DeleteTransaction"
+	TransactionService_RestoreTransaction_FullMethodName         = "/transaction.TransactionService/RestoreTransaction"
+	TransactionService_ListTransactions_FullMethodName           = "/transaction.TransactionService/ListTransactions"
+	TransactionService_GetTransactionsByUser_FullMethodName      = "/transaction.TransactionService/GetTransactionsByUser"
+	TransactionService_GetTransactionsBySkin_FullMethodName      = "/transaction.TransactionService/GetTransactionsBySkin"
+	TransactionService_GetTransactionsByStatus_FullMethodName    = "/transaction.TransactionService/GetTransactionsByStatus"
+	TransactionService_ProcessPurchase_FullMethodName            = "/transaction.TransactionService/ProcessPurchase"
+	TransactionService_ProcessCartPurchase_FullMethodName        = "/transaction.TransactionService/ProcessCartPurchase"
+	TransactionService_CancelTransaction_FullMethodName          = "/transaction.TransactionService/CancelTransaction"
+	TransactionService_CreateTrade_FullMethodName                = "/transaction.TransactionService/CreateTrade"
+	TransactionService_AcceptTrade_FullMethodName                = "/transaction.TransactionService/AcceptTrade"
+	TransactionService_QuoteFees_FullMethodName                  = "/transaction.TransactionService/QuoteFees"
+	TransactionService_WatchTransactions_FullMethodName          = "/transaction.TransactionService/WatchTransactions"
+	TransactionService_OpenDispute_FullMethodName                = "/transaction.TransactionService/OpenDispute"
+	TransactionService_GetDispute_FullMethodName                 = "/transaction.TransactionService/GetDispute"
+	TransactionService_ListDisputes_FullMethodName               = "/transaction.TransactionService/ListDisputes"
+	TransactionService_ResolveDispute_FullMethodName             = "/transaction.TransactionService/ResolveDispute"
+	TransactionService_GetTransactionStats_FullMethodName        = "/transaction.TransactionService/GetTransactionStats"
+	TransactionService_GetTransactionVolumeSeries_FullMethodName = "/transaction.TransactionService/GetTransactionVolumeSeries"
+	TransactionService_GetSkinPriceHistory_FullMethodName        = "/transaction.TransactionService/GetSkinPriceHistory"
+	TransactionService_GetLeaderboard_FullMethodName             = "/transaction.TransactionService/GetLeaderboard"
+	TransactionService_GetAllTransactions_FullMethodName         = "/transaction.TransactionService/GetAllTransactions"
+	TransactionService_ExportTransactions_FullMethodName         = "/transaction.TransactionService/ExportTransactions"
 )
 
 // TransactionServiceClient is the client API for TransactionService service.
@@ -40,8 +56,11 @@ type TransactionServiceClient interface {
 	// Basic CRUD operations
 	CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	GetTransactionHistory(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*TransactionHistoryResponse, error)
+	GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*ReceiptResponse, error)
 	UpdateTransaction(ctx context.Context, in *UpdateTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	DeleteTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	RestoreTransaction(ctx context.Context, in *RestoreTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	// List operations
 	ListTransactions(ctx context.Context, in *GetTransactionsByUserRequest, opts ...grpc.CallOption) (*TransactionListResponse, error)
 	GetTransactionsByUser(ctx context.Context, in *GetTransactionsByUserRequest, opts ...grpc.CallOption) (*TransactionListResponse, error)
@@ -49,10 +68,24 @@ type TransactionServiceClient interface {
 	GetTransactionsByStatus(ctx context.Context, in *GetTransactionsByStatusRequest, opts ...grpc.CallOption) (*TransactionListResponse, error)
 	// Business operations
 	ProcessPurchase(ctx context.Context, in *ProcessPurchaseRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	ProcessCartPurchase(ctx context.Context, in *ProcessCartPurchaseRequest, opts ...grpc.CallOption) (*CartPurchaseResponse, error)
 	CancelTransaction(ctx context.Context, in *CancelTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	CreateTrade(ctx context.Context, in *CreateTradeRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	AcceptTrade(ctx context.Context, in *AcceptTradeRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	QuoteFees(ctx context.Context, in *QuoteFeesRequest, opts ...grpc.CallOption) (*QuoteFeesResponse, error)
+	WatchTransactions(ctx context.Context, in *WatchTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TransactionEvent], error)
+	// Disputes and refunds
+	OpenDispute(ctx context.Context, in *OpenDisputeRequest, opts ...grpc.CallOption) (*DisputeResponse, error)
+	GetDispute(ctx context.Context, in *GetDisputeRequest, opts ...grpc.CallOption) (*DisputeResponse, error)
+	ListDisputes(ctx context.Context, in *ListDisputesRequest, opts ...grpc.CallOption) (*DisputeListResponse, error)
+	ResolveDispute(ctx context.Context, in *ResolveDisputeRequest, opts ...grpc.CallOption) (*DisputeResponse, error)
 	// Analytics and reporting
 	GetTransactionStats(ctx context.Context, in *GetTransactionStatsRequest, opts ...grpc.CallOption) (*TransactionStatsResponse, error)
+	GetTransactionVolumeSeries(ctx context.Context, in *GetTransactionVolumeSeriesRequest, opts ...grpc.CallOption) (*TransactionVolumeSeriesResponse, error)
+	GetSkinPriceHistory(ctx context.Context, in *GetSkinPriceHistoryRequest, opts ...grpc.CallOption) (*SkinPriceHistoryResponse, error)
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error)
 	GetAllTransactions(ctx context.Context, in *GetTransactionsByStatusRequest, opts ...grpc.CallOption) (*TransactionListResponse, error)
+	ExportTransactions(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
 }
 
 type transactionServiceClient struct {
@@ -83,6 +116,26 @@ func (c *transactionServiceClient) GetTransaction(ctx context.Context, in *GetTr
 	return out, nil
 }
 
+func (c *transactionServiceClient) GetTransactionHistory(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*TransactionHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionHistoryResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetTransactionHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*ReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceiptResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) UpdateTransaction(ctx context.Context, in *UpdateTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TransactionResponse)
@@ -103,6 +156,16 @@ func (c *transactionServiceClient) DeleteTransaction(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *transactionServiceClient) RestoreTransaction(ctx context.Context, in *RestoreTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_RestoreTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) ListTransactions(ctx context.Context, in *GetTransactionsByUserRequest, opts ...grpc.CallOption) (*TransactionListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TransactionListResponse)
@@ -153,6 +216,16 @@ func (c *transactionServiceClient) ProcessPurchase(ctx context.Context, in *Proc
 	return out, nil
 }
 
+func (c *transactionServiceClient) ProcessCartPurchase(ctx context.Context, in *ProcessCartPurchaseRequest, opts ...grpc.CallOption) (*CartPurchaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartPurchaseResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ProcessCartPurchase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) CancelTransaction(ctx context.Context, in *CancelTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TransactionResponse)
@@ -163,6 +236,95 @@ func (c *transactionServiceClient) CancelTransaction(ctx context.Context, in *Ca
 	return out, nil
 }
 
+func (c *transactionServiceClient) CreateTrade(ctx context.Context, in *CreateTradeRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_CreateTrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) AcceptTrade(ctx context.Context, in *AcceptTradeRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, TransactionService_AcceptTrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) QuoteFees(ctx context.Context, in *QuoteFeesRequest, opts ...grpc.CallOption) (*QuoteFeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteFeesResponse)
+	err := c.cc.Invoke(ctx, TransactionService_QuoteFees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) WatchTransactions(ctx context.Context, in *WatchTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TransactionEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TransactionService_ServiceDesc.Streams[0], TransactionService_WatchTransactions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTransactionsRequest, TransactionEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_WatchTransactionsClient = grpc.ServerStreamingClient[TransactionEvent]
+
+func (c *transactionServiceClient) OpenDispute(ctx context.Context, in *OpenDisputeRequest, opts ...grpc.CallOption) (*DisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisputeResponse)
+	err := c.cc.Invoke(ctx, TransactionService_OpenDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetDispute(ctx context.Context, in *GetDisputeRequest, opts ...grpc.CallOption) (*DisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisputeResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListDisputes(ctx context.Context, in *ListDisputesRequest, opts ...grpc.CallOption) (*DisputeListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisputeListResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListDisputes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ResolveDispute(ctx context.Context, in *ResolveDisputeRequest, opts ...grpc.CallOption) (*DisputeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisputeResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ResolveDispute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) GetTransactionStats(ctx context.Context, in *GetTransactionStatsRequest, opts ...grpc.CallOption) (*TransactionStatsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TransactionStatsResponse)
@@ -173,6 +335,36 @@ func (c *transactionServiceClient) GetTransactionStats(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *transactionServiceClient) GetTransactionVolumeSeries(ctx context.Context, in *GetTransactionVolumeSeriesRequest, opts ...grpc.CallOption) (*TransactionVolumeSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionVolumeSeriesResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetTransactionVolumeSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetSkinPriceHistory(ctx context.Context, in *GetSkinPriceHistoryRequest, opts ...grpc.CallOption) (*SkinPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkinPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetSkinPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*LeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaderboardResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactionServiceClient) GetAllTransactions(ctx context.Context, in *GetTransactionsByStatusRequest, opts ...grpc.CallOption) (*TransactionListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TransactionListResponse)
@@ -183,6 +375,25 @@ func (c *transactionServiceClient) GetAllTransactions(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *transactionServiceClient) ExportTransactions(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TransactionService_ServiceDesc.Streams[1], TransactionService_ExportTransactions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportTransactionsRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_ExportTransactionsClient = grpc.ServerStreamingClient[ExportChunk]
+
 // TransactionServiceServer is the server API for TransactionService service.
 // All implementations must embed UnimplementedTransactionServiceServer
 // for forward compatibility.
@@ -190,8 +401,11 @@ type TransactionServiceServer interface {
 	// Basic CRUD operations
 	CreateTransaction(context.Context, *CreateTransactionRequest) (*TransactionResponse, error)
 	GetTransaction(context.Context, *GetTransactionRequest) (*TransactionResponse, error)
+	GetTransactionHistory(context.Context, *GetTransactionRequest) (*TransactionHistoryResponse, error)
+	GetReceipt(context.Context, *GetReceiptRequest) (*ReceiptResponse, error)
 	UpdateTransaction(context.Context, *UpdateTransactionRequest) (*TransactionResponse, error)
 	DeleteTransaction(context.Context, *GetTransactionRequest) (*DeleteResponse, error)
+	RestoreTransaction(context.Context, *RestoreTransactionRequest) (*TransactionResponse, error)
 	// List operations
 	ListTransactions(context.Context, *GetTransactionsByUserRequest) (*TransactionListResponse, error)
 	GetTransactionsByUser(context.Context, *GetTransactionsByUserRequest) (*TransactionListResponse, error)
@@ -199,10 +413,24 @@ type TransactionServiceServer interface {
 	GetTransactionsByStatus(context.Context, *GetTransactionsByStatusRequest) (*TransactionListResponse, error)
 	// Business operations
 	ProcessPurchase(context.Context, *ProcessPurchaseRequest) (*TransactionResponse, error)
+	ProcessCartPurchase(context.Context, *ProcessCartPurchaseRequest) (*CartPurchaseResponse, error)
 	CancelTransaction(context.Context, *CancelTransactionRequest) (*TransactionResponse, error)
+	CreateTrade(context.Context, *CreateTradeRequest) (*TransactionResponse, error)
+	AcceptTrade(context.Context, *AcceptTradeRequest) (*TransactionResponse, error)
+	QuoteFees(context.Context, *QuoteFeesRequest) (*QuoteFeesResponse, error)
+	WatchTransactions(*WatchTransactionsRequest, grpc.ServerStreamingServer[TransactionEvent]) error
+	// Disputes and refunds
+	OpenDispute(context.Context, *OpenDisputeRequest) (*DisputeResponse, error)
+	GetDispute(context.Context, *GetDisputeRequest) (*DisputeResponse, error)
+	ListDisputes(context.Context, *ListDisputesRequest) (*DisputeListResponse, error)
+	ResolveDispute(context.Context, *ResolveDisputeRequest) (*DisputeResponse, error)
 	// Analytics and reporting
 	GetTransactionStats(context.Context, *GetTransactionStatsRequest) (*TransactionStatsResponse, error)
+	GetTransactionVolumeSeries(context.Context, *GetTransactionVolumeSeriesRequest) (*TransactionVolumeSeriesResponse, error)
+	GetSkinPriceHistory(context.Context, *GetSkinPriceHistoryRequest) (*SkinPriceHistoryResponse, error)
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*LeaderboardResponse, error)
 	GetAllTransactions(context.Context, *GetTransactionsByStatusRequest) (*TransactionListResponse, error)
+	ExportTransactions(*ExportTransactionsRequest, grpc.ServerStreamingServer[ExportChunk]) error
 	mustEmbedUnimplementedTransactionServiceServer()
 }
 
@@ -219,12 +447,21 @@ func (UnimplementedTransactionServiceServer) CreateTransaction(context.Context,
 func (UnimplementedTransactionServiceServer) GetTransaction(context.Context, *GetTransactionRequest) (*TransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
 }
+func (UnimplementedTransactionServiceServer) GetTransactionHistory(context.Context, *GetTransactionRequest) (*TransactionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionHistory not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetReceipt(context.Context, *GetReceiptRequest) (*ReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceipt not implemented")
+}
 func (UnimplementedTransactionServiceServer) UpdateTransaction(context.Context, *UpdateTransactionRequest) (*TransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransaction not implemented")
 }
 func (UnimplementedTransactionServiceServer) DeleteTransaction(context.Context, *GetTransactionRequest) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransaction not implemented")
 }
+func (UnimplementedTransactionServiceServer) RestoreTransaction(context.Context, *RestoreTransactionRequest) (*TransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTransaction not implemented")
+}
 func (UnimplementedTransactionServiceServer) ListTransactions(context.Context, *GetTransactionsByUserRequest) (*TransactionListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
 }
@@ -240,15 +477,54 @@ func (UnimplementedTransactionServiceServer) GetTransactionsByStatus(context.Con
 func (UnimplementedTransactionServiceServer) ProcessPurchase(context.Context, *ProcessPurchaseRequest) (*TransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessPurchase not implemented")
 }
+func (UnimplementedTransactionServiceServer) ProcessCartPurchase(context.Context, *ProcessCartPurchaseRequest) (*CartPurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessCartPurchase not implemented")
+}
 func (UnimplementedTransactionServiceServer) CancelTransaction(context.Context, *CancelTransactionRequest) (*TransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelTransaction not implemented")
 }
+func (UnimplementedTransactionServiceServer) CreateTrade(context.Context, *CreateTradeRequest) (*TransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrade not implemented")
+}
+func (UnimplementedTransactionServiceServer) AcceptTrade(context.Context, *AcceptTradeRequest) (*TransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTrade not implemented")
+}
+func (UnimplementedTransactionServiceServer) QuoteFees(context.Context, *QuoteFeesRequest) (*QuoteFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteFees not implemented")
+}
+func (UnimplementedTransactionServiceServer) WatchTransactions(*WatchTransactionsRequest, grpc.ServerStreamingServer[TransactionEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) OpenDispute(context.Context, *OpenDisputeRequest) (*DisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenDispute not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetDispute(context.Context, *GetDisputeRequest) (*DisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDispute not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListDisputes(context.Context, *ListDisputesRequest) (*DisputeListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDisputes not implemented")
+}
+func (UnimplementedTransactionServiceServer) ResolveDispute(context.Context, *ResolveDisputeRequest) (*DisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDispute not implemented")
+}
 func (UnimplementedTransactionServiceServer) GetTransactionStats(context.Context, *GetTransactionStatsRequest) (*TransactionStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionStats not implemented")
 }
+func (UnimplementedTransactionServiceServer) GetTransactionVolumeSeries(context.Context, *GetTransactionVolumeSeriesRequest) (*TransactionVolumeSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionVolumeSeries not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetSkinPriceHistory(context.Context, *GetSkinPriceHistoryRequest) (*SkinPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSkinPriceHistory not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*LeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
 func (UnimplementedTransactionServiceServer) GetAllTransactions(context.Context, *GetTransactionsByStatusRequest) (*TransactionListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllTransactions not implemented")
 }
+func (UnimplementedTransactionServiceServer) ExportTransactions(*ExportTransactionsRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTransactions not implemented")
+}
 func (UnimplementedTransactionServiceServer) mustEmbedUnimplementedTransactionServiceServer() {}
 func (UnimplementedTransactionServiceServer) testEmbeddedByValue()                            {}
 
@@ -306,6 +582,42 @@ func _TransactionService_GetTransaction_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_GetTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetTransactionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetTransactionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetTransactionHistory(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetReceipt(ctx, req.(*GetReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransactionService_UpdateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateTransactionRequest)
 	if err := dec(in); err != nil {
@@ -342,6 +654,24 @@ func _TransactionService_DeleteTransaction_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_RestoreTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).RestoreTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_RestoreTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).RestoreTransaction(ctx, req.(*RestoreTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransactionService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTransactionsByUserRequest)
 	if err := dec(in); err != nil {
@@ -432,6 +762,24 @@ func _TransactionService_ProcessPurchase_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_ProcessCartPurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessCartPurchaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ProcessCartPurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ProcessCartPurchase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ProcessCartPurchase(ctx, req.(*ProcessCartPurchaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransactionService_CancelTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelTransactionRequest)
 	if err := dec(in); err != nil {
@@ -450,6 +798,143 @@ func _TransactionService_CancelTransaction_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_CreateTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).CreateTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_CreateTrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).CreateTrade(ctx, req.(*CreateTradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_AcceptTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).AcceptTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_AcceptTrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).AcceptTrade(ctx, req.(*AcceptTradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_QuoteFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).QuoteFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_QuoteFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).QuoteFees(ctx, req.(*QuoteFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_WatchTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionServiceServer).WatchTransactions(m, &grpc.GenericServerStream[WatchTransactionsRequest, TransactionEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_WatchTransactionsServer = grpc.ServerStreamingServer[TransactionEvent]
+
+func _TransactionService_OpenDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).OpenDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_OpenDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).OpenDispute(ctx, req.(*OpenDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetDispute(ctx, req.(*GetDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListDisputes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDisputesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListDisputes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListDisputes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListDisputes(ctx, req.(*ListDisputesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ResolveDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ResolveDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ResolveDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ResolveDispute(ctx, req.(*ResolveDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransactionService_GetTransactionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTransactionStatsRequest)
 	if err := dec(in); err != nil {
@@ -468,6 +953,60 @@ func _TransactionService_GetTransactionStats_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_GetTransactionVolumeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionVolumeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetTransactionVolumeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetTransactionVolumeSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetTransactionVolumeSeries(ctx, req.(*GetTransactionVolumeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetSkinPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkinPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetSkinPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetSkinPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetSkinPriceHistory(ctx, req.(*GetSkinPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransactionService_GetAllTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTransactionsByStatusRequest)
 	if err := dec(in); err != nil {
@@ -486,6 +1025,17 @@ func _TransactionService_GetAllTransactions_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_ExportTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionServiceServer).ExportTransactions(m, &grpc.GenericServerStream[ExportTransactionsRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_ExportTransactionsServer = grpc.ServerStreamingServer[ExportChunk]
+
 // TransactionService_ServiceDesc is the grpc.ServiceDesc for TransactionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -501,6 +1051,14 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransaction",
 			Handler:    _TransactionService_GetTransaction_Handler,
 		},
+		{
+			MethodName: "GetTransactionHistory",
+			Handler:    _TransactionService_GetTransactionHistory_Handler,
+		},
+		{
+			MethodName: "GetReceipt",
+			Handler:    _TransactionService_GetReceipt_Handler,
+		},
 		{
 			MethodName: "UpdateTransaction",
 			Handler:    _TransactionService_UpdateTransaction_Handler,
@@ -509,6 +1067,10 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTransaction",
 			Handler:    _TransactionService_DeleteTransaction_Handler,
 		},
+		{
+			MethodName: "RestoreTransaction",
+			Handler:    _TransactionService_RestoreTransaction_Handler,
+		},
 		{
 			MethodName: "ListTransactions",
 			Handler:    _TransactionService_ListTransactions_Handler,
@@ -529,19 +1091,74 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessPurchase",
 			Handler:    _TransactionService_ProcessPurchase_Handler,
 		},
+		{
+			MethodName: "ProcessCartPurchase",
+			Handler:    _TransactionService_ProcessCartPurchase_Handler,
+		},
 		{
 			MethodName: "CancelTransaction",
 			Handler:    _TransactionService_CancelTransaction_Handler,
 		},
+		{
+			MethodName: "CreateTrade",
+			Handler:    _TransactionService_CreateTrade_Handler,
+		},
+		{
+			MethodName: "AcceptTrade",
+			Handler:    _TransactionService_AcceptTrade_Handler,
+		},
+		{
+			MethodName: "QuoteFees",
+			Handler:    _TransactionService_QuoteFees_Handler,
+		},
+		{
+			MethodName: "OpenDispute",
+			Handler:    _TransactionService_OpenDispute_Handler,
+		},
+		{
+			MethodName: "GetDispute",
+			Handler:    _TransactionService_GetDispute_Handler,
+		},
+		{
+			MethodName: "ListDisputes",
+			Handler:    _TransactionService_ListDisputes_Handler,
+		},
+		{
+			MethodName: "ResolveDispute",
+			Handler:    _TransactionService_ResolveDispute_Handler,
+		},
 		{
 			MethodName: "GetTransactionStats",
 			Handler:    _TransactionService_GetTransactionStats_Handler,
 		},
+		{
+			MethodName: "GetTransactionVolumeSeries",
+			Handler:    _TransactionService_GetTransactionVolumeSeries_Handler,
+		},
+		{
+			MethodName: "GetSkinPriceHistory",
+			Handler:    _TransactionService_GetSkinPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _TransactionService_GetLeaderboard_Handler,
+		},
 		{
 			MethodName: "GetAllTransactions",
 			Handler:    _TransactionService_GetAllTransactions_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTransactions",
+			Handler:       _TransactionService_WatchTransactions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportTransactions",
+			Handler:       _TransactionService_ExportTransactions_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "shared/proto/transaction.proto",
 }
@@ -65,9 +65,21 @@ func main() {
 
 	// Initialize repositories
 	db := mongoClient.Database(cfg.MongoDBName)
+	migrated, err := mongorepo.MigrateMoney(context.Background(), db, cfg.Currency)
+	if err != nil {
+		log.Fatalf("Failed to migrate balances: %v", err)
+	}
+	if migrated > 0 {
+		log.Printf("Migrated %d balances and holds to %s", migrated, cfg.Currency)
+	}
+
 	userRepo := mongorepo.NewUserRepository(db)
 	sessionRepo := mongorepo.NewSessionRepository(db)
 	tokenRepo := mongorepo.NewPasswordResetTokenRepository(db)
+	holdRepo := mongorepo.NewBalanceHoldRepository(db)
+	if err := holdRepo.EnsureIndexes(context.Background()); err != nil {
+		log.Fatalf("Failed to create balance hold indexes: %v", err)
+	}
 
 	// Initialize use cases
 	emailSender := email.NewGMailSender(cfg.EmailUser, cfg.EmailPassword)
@@ -75,8 +87,10 @@ func main() {
 		userRepo,
 		sessionRepo,
 		tokenRepo,
+		holdRepo,
 		emailSender,
 		natsClient,
+		cfg.Currency,
 	)
 
 	// Create gRPC server
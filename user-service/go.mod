@@ -3,6 +3,7 @@ module cs2-marketplace-microservices/user-service
 go 1.24.2
 
 require (
+	cs2-marketplace-microservices/shared v0.0.0
 	github.com/patrickmn/go-cache v2.1.0+incompatible
 	github.com/prometheus/client_golang v1.22.0
 	go.mongodb.org/mongo-driver v1.17.3
@@ -37,3 +38,5 @@ require (
 	google.golang.org/grpc v1.72.2
 	google.golang.org/protobuf v1.36.5
 )
+
+replace cs2-marketplace-microservices/shared => ../shared
@@ -59,6 +59,19 @@ func (h *UserHandler) LogoutUser(ctx context.Context, req *user.LogoutRequest) (
 	}, nil
 }
 
+// ValidateSession returns the owner of a session, letting other services
+// authenticate callers without trusting a user id they supply
+func (h *UserHandler) ValidateSession(ctx context.Context, req *user.ValidateSessionRequest) (*user.ValidateSessionResponse, error) {
+	userModel, err := h.userUC.ValidateSession(ctx, req.GetSessionToken())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired session")
+	}
+
+	return &user.ValidateSessionResponse{
+		User: userModel.ToProto(),
+	}, nil
+}
+
 func (h *UserHandler) GetUser(ctx context.Context, req *user.GetUserRequest) (*user.GetUserResponse, error) {
 	userModel, err := h.userUC.GetUserProfile(ctx, req.GetUserId())
 	if err != nil {
@@ -71,7 +84,7 @@ func (h *UserHandler) GetUser(ctx context.Context, req *user.GetUserRequest) (*u
 }
 
 func (h *UserHandler) UpdateUser(ctx context.Context, req *user.UpdateUserRequest) (*user.UpdateUserResponse, error) {
-	userModel, err := h.userUC.UpdateUserProfile(ctx, req.GetUserId(), req.GetUsername(), req.GetEmail())
+	userModel, err := h.userUC.UpdateUserProfile(ctx, req.GetUserId(), req.GetUsername(), req.GetEmail(), req.LeaderboardOptOut)
 	if err != nil {
 		if errors.Is(err, usecase.ErrEmailExists) || errors.Is(err, usecase.ErrUsernameExists) {
 			return nil, status.Error(codes.AlreadyExists, err.Error())
@@ -138,23 +151,30 @@ func (h *UserHandler) GetBalance(ctx context.Context, req *user.GetBalanceReques
 	}
 
 	return &user.GetBalanceResponse{
-		Balance: balance,
+		Balance:          balance.Total.ToProto(),
+		AvailableBalance: balance.Available().ToProto(),
+		HeldBalance:      balance.Held.ToProto(),
 	}, nil
 }
 
 func (h *UserHandler) UpdateBalance(ctx context.Context, req *user.UpdateBalanceRequest) (*user.UpdateBalanceResponse, error) {
-	var amount float64
+	amount := models.MoneyFromProto(req.GetAmount())
 	switch req.GetOperation() {
 	case "add":
-		amount = req.GetAmount()
 	case "subtract":
-		amount = -req.GetAmount()
+		amount = amount.Neg()
 	default:
 		return nil, status.Error(codes.InvalidArgument, "invalid operation")
 	}
 
-	err := h.userUC.UpdateBalance(ctx, req.GetUserId(), amount)
+	err := h.userUC.UpdateBalance(ctx, req.GetUserId(), amount, models.ConversionsFromProto(req.GetConversions()))
 	if err != nil {
+		if errors.Is(err, usecase.ErrInsufficientBalance) {
+			return nil, status.Error(codes.FailedPrecondition, "insufficient balance")
+		}
+		if isCurrencyError(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to update balance")
 	}
 
@@ -165,16 +185,19 @@ func (h *UserHandler) UpdateBalance(ctx context.Context, req *user.UpdateBalance
 	}
 
 	return &user.UpdateBalanceResponse{
-		NewBalance: balance,
+		NewBalance: balance.Total.ToProto(),
 	}, nil
 }
 
 func (h *UserHandler) TransferBalance(ctx context.Context, req *user.TransferBalanceRequest) (*user.TransferBalanceResponse, error) {
-	err := h.userUC.TransferBalance(ctx, req.GetFromUserId(), req.GetToUserId(), req.GetAmount())
+	err := h.userUC.TransferBalance(ctx, req.GetFromUserId(), req.GetToUserId(), models.MoneyFromProto(req.GetAmount()), models.ConversionsFromProto(req.GetConversions()))
 	if err != nil {
 		if errors.Is(err, usecase.ErrInsufficientBalance) {
 			return nil, status.Error(codes.FailedPrecondition, "insufficient balance")
 		}
+		if isCurrencyError(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "transfer failed")
 	}
 
@@ -184,6 +207,88 @@ func (h *UserHandler) TransferBalance(ctx context.Context, req *user.TransferBal
 	}, nil
 }
 
+func (h *UserHandler) PlaceHold(ctx context.Context, req *user.PlaceHoldRequest) (*user.PlaceHoldResponse, error) {
+	hold, err := h.userUC.PlaceHold(ctx, req.GetUserId(), models.MoneyFromProto(req.GetAmount()), models.ConversionsFromProto(req.GetConversions()), req.GetReference())
+	if err != nil {
+		if errors.Is(err, usecase.ErrInsufficientBalance) {
+			return nil, status.Error(codes.FailedPrecondition, "insufficient balance")
+		}
+		if isCurrencyError(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to place hold")
+	}
+
+	return &user.PlaceHoldResponse{
+		Hold: hold.ToProto(),
+	}, nil
+}
+
+func (h *UserHandler) CaptureHold(ctx context.Context, req *user.CaptureHoldRequest) (*user.CaptureHoldResponse, error) {
+	var creditAmount *models.Money
+	if req.GetCreditAmount() != nil {
+		credit := models.MoneyFromProto(req.GetCreditAmount())
+		creditAmount = &credit
+	}
+
+	hold, err := h.userUC.CaptureHold(ctx, req.GetHoldId(), req.GetToUserId(), creditAmount, models.ConversionsFromProto(req.GetConversions()))
+	if err != nil {
+		return nil, holdStatusError(err, "failed to capture hold")
+	}
+
+	return &user.CaptureHoldResponse{
+		Hold: hold.ToProto(),
+	}, nil
+}
+
+func (h *UserHandler) ReleaseHold(ctx context.Context, req *user.ReleaseHoldRequest) (*user.ReleaseHoldResponse, error) {
+	hold, err := h.userUC.ReleaseHold(ctx, req.GetHoldId())
+	if err != nil {
+		return nil, holdStatusError(err, "failed to release hold")
+	}
+
+	return &user.ReleaseHoldResponse{
+		Hold: hold.ToProto(),
+	}, nil
+}
+
+func (h *UserHandler) ListHolds(ctx context.Context, req *user.ListHoldsRequest) (*user.ListHoldsResponse, error) {
+	if req.GetReference() == "" {
+		return nil, status.Error(codes.InvalidArgument, "reference is required")
+	}
+
+	holds, err := h.userUC.ListHolds(ctx, req.GetReference())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list holds")
+	}
+
+	resp := &user.ListHoldsResponse{Holds: make([]*user.BalanceHold, 0, len(holds))}
+	for _, hold := range holds {
+		resp.Holds = append(resp.Holds, hold.ToProto())
+	}
+	return resp, nil
+}
+
+func holdStatusError(err error, msg string) error {
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Error(codes.NotFound, "hold not found")
+	case errors.Is(err, models.ErrHoldNotActive):
+		return status.Error(codes.FailedPrecondition, "hold is not active")
+	case errors.Is(err, models.ErrInvalidCredit), isCurrencyError(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, msg)
+	}
+}
+
+// isCurrencyError reports whether err comes from amounts in different
+// currencies without a usable conversion
+func isCurrencyError(err error) bool {
+	return errors.Is(err, models.ErrCurrencyMismatch) || errors.Is(err, models.ErrInvalidConversion) ||
+		errors.Is(err, models.ErrAmountOutOfRange)
+}
+
 func (h *UserHandler) AdminGetAllUsers(ctx context.Context, req *user.AdminGetAllUsersRequest) (*user.AdminGetAllUsersResponse, error) {
 	// First validate admin privileges
 	adminUser, err := h.userUC.ValidateSession(ctx, req.GetAdminToken())
@@ -224,6 +329,9 @@ func (h *UserHandler) AdminUpdateUser(ctx context.Context, req *user.AdminUpdate
 
 	updatedUser, err := h.userUC.AdminUpdateUser(ctx, adminUser.ID.Hex(), req.GetUserId(), updates)
 	if err != nil {
+		if errors.Is(err, models.ErrFundsHeld) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to update user")
 	}
 
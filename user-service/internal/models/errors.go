@@ -1,9 +1,20 @@
 package models
 
-import "errors"
+import (
+	"errors"
+
+	"cs2-marketplace-microservices/shared/money"
+)
 
 var (
 	ErrNotFound           = errors.New("not found")
 	ErrEmailExists        = errors.New("email already exists")
 	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrInsufficientFunds  = errors.New("insufficient available balance")
+	ErrHoldNotActive      = errors.New("hold is not active")
+	ErrInvalidCredit      = errors.New("credit amount must be between 0 and the held amount")
+	ErrCurrencyMismatch   = money.ErrCurrencyMismatch
+	ErrInvalidConversion  = money.ErrInvalidConversion
+	ErrAmountOutOfRange   = money.ErrOutOfRange
+	ErrFundsHeld          = errors.New("the currency of a balance with held funds cannot change")
 )
@@ -12,14 +12,48 @@ import (
 
 // User represents the core user entity in the system
 type User struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	Username  string             `bson:"username" json:"username" validate:"required,min=3,max=50"`
-	Email     string             `bson:"email" json:"email" validate:"required,email"`
-	Password  string             `bson:"password" json:"-" validate:"required,min=8"`
-	Balance   float64            `bson:"balance" json:"balance"`
-	IsAdmin   bool               `bson:"is_admin" json:"is_admin"`
-	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Username    string             `bson:"username" json:"username" validate:"required,min=3,max=50"`
+	Email       string             `bson:"email" json:"email" validate:"required,email"`
+	Password    string             `bson:"password" json:"-" validate:"required,min=8"`
+	Balance     Money              `bson:"balance" json:"balance"`
+	HeldBalance Money              `bson:"held_balance" json:"held_balance"` // always in the currency of Balance
+	IsAdmin     bool               `bson:"is_admin" json:"is_admin"`
+	// LeaderboardOptOut hides the user from public leaderboards
+	LeaderboardOptOut bool      `bson:"leaderboard_opt_out" json:"leaderboard_opt_out"`
+	CreatedAt         time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt         time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+// Balance is a snapshot of a user's funds
+type Balance struct {
+	Total Money
+	Held  Money
+}
+
+// Available returns the part of the balance that is not held
+func (b *Balance) Available() Money {
+	return Money{Units: b.Total.Units - b.Held.Units, Currency: b.Total.Currency}
+}
+
+type HoldStatus string
+
+const (
+	HoldActive   HoldStatus = "ACTIVE"
+	HoldCaptured HoldStatus = "CAPTURED"
+	HoldReleased HoldStatus = "RELEASED"
+)
+
+// BalanceHold reserves part of a user's balance until it is captured or released
+type BalanceHold struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID     string             `bson:"user_id" json:"user_id"`
+	Amount     Money              `bson:"amount" json:"amount"` // in the currency of the user's balance
+	Reference  string             `bson:"reference" json:"reference"`
+	Status     HoldStatus         `bson:"status" json:"status"`
+	CapturedTo string             `bson:"captured_to,omitempty" json:"captured_to,omitempty"`
+	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt  time.Time          `bson:"updated_at" json:"updated_at"`
 }
 
 // Session represents an active user session
@@ -36,21 +70,23 @@ type PasswordResetToken struct {
 	ExpiresAt time.Time `bson:"expires_at" json:"expires_at"`
 }
 
-// NewUser creates a new User instance with hashed password
-func NewUser(username, email, password string, isAdmin bool) (*User, error) {
+// NewUser creates a new User instance with hashed password and an empty
+// balance in currency
+func NewUser(username, email, password string, isAdmin bool, currency string) (*User, error) {
 	hashedPassword, err := hashPassword(password)
 	if err != nil {
 		return nil, err
 	}
 
 	return &User{
-		Username:  username,
-		Email:     email,
-		Password:  hashedPassword,
-		Balance:   0.0,
-		IsAdmin:   isAdmin,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		Username:    username,
+		Email:       email,
+		Password:    hashedPassword,
+		Balance:     Money{Currency: currency},
+		HeldBalance: Money{Currency: currency},
+		IsAdmin:     isAdmin,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
 	}, nil
 }
 
@@ -79,10 +115,26 @@ func (u *User) ToProto() *user.User {
 		Id:        u.ID.Hex(),
 		Username:  u.Username,
 		Email:     u.Email,
-		Balance:   u.Balance,
+		Balance:   u.Balance.ToProto(),
 		IsAdmin:   u.IsAdmin,
 		CreatedAt: u.CreatedAt.Format(time.RFC3339),
 		UpdatedAt: u.UpdatedAt.Format(time.RFC3339),
+
+		LeaderboardOptOut: u.LeaderboardOptOut,
+	}
+}
+
+// ToProto converts BalanceHold model to gRPC proto BalanceHold
+func (h *BalanceHold) ToProto() *user.BalanceHold {
+	return &user.BalanceHold{
+		Id:         h.ID.Hex(),
+		UserId:     h.UserID,
+		Amount:     h.Amount.ToProto(),
+		Reference:  h.Reference,
+		Status:     string(h.Status),
+		CapturedTo: h.CapturedTo,
+		CreatedAt:  h.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  h.UpdatedAt.Format(time.RFC3339),
 	}
 }
 
@@ -110,9 +162,11 @@ func FromProto(protoUser *user.User) (*User, error) {
 		ID:        id,
 		Username:  protoUser.GetUsername(),
 		Email:     protoUser.GetEmail(),
-		Balance:   protoUser.GetBalance(),
+		Balance:   MoneyFromProto(protoUser.GetBalance()),
 		IsAdmin:   protoUser.GetIsAdmin(),
 		CreatedAt: createdAt,
 		UpdatedAt: updatedAt,
+
+		LeaderboardOptOut: protoUser.GetLeaderboardOptOut(),
 	}, nil
 }
@@ -15,7 +15,11 @@ type UserRepository interface {
 	DeleteUser(ctx context.Context, id string) error
 
 	// Balance operations
-	UpdateUserBalance(ctx context.Context, id string, amount float64) error
+	UpdateUserBalance(ctx context.Context, id string, amount Money) error
+	DebitBalance(ctx context.Context, id string, amount Money) error
+	SetBalance(ctx context.Context, id string, balance Money) error
+	ReserveBalance(ctx context.Context, id string, amount Money) error
+	AdjustHeldBalance(ctx context.Context, id, currency string, balanceDelta, heldDelta int64) error
 
 	// Admin operations
 	GetAllUsers(ctx context.Context, page, limit int64) ([]*User, error)
@@ -37,3 +41,12 @@ type PasswordResetTokenRepository interface {
 	DeletePasswordResetToken(ctx context.Context, token string) error
 	DeleteExpiredPasswordResetTokens(ctx context.Context) error
 }
+
+// BalanceHoldRepository defines the interface for balance holds
+type BalanceHoldRepository interface {
+	CreateHold(ctx context.Context, hold *BalanceHold) error
+	GetHold(ctx context.Context, id string) (*BalanceHold, error)
+	SettleHold(ctx context.Context, id string, status HoldStatus, capturedTo string) (*BalanceHold, error)
+	ReactivateHold(ctx context.Context, id string, from HoldStatus) error
+	ListHoldsByReference(ctx context.Context, reference string) ([]*BalanceHold, error)
+}
@@ -0,0 +1,22 @@
+package mongo
+
+import (
+	"context"
+
+	"cs2-marketplace-microservices/shared/money"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MigrateMoney converts balances and held amounts stored as plain numbers,
+// from before amounts were Money, to Money in currency. It returns the number
+// of documents migrated and can safely run on every start.
+func MigrateMoney(ctx context.Context, db *mongo.Database, currency string) (int64, error) {
+	users, err := money.MigrateFields(ctx, db.Collection("users"), currency, "balance", "held_balance")
+	if err != nil {
+		return users, err
+	}
+
+	holds, err := money.MigrateFields(ctx, db.Collection("balance_holds"), currency, "amount")
+	return users + holds, err
+}
@@ -66,10 +66,34 @@ func (r *userRepository) UpdateUser(ctx context.Context, user *models.User) erro
 		return errors.New("user ID is required for update")
 	}
 
-	_, err := r.collection.UpdateByID(ctx, user.ID, bson.M{"$set": user})
+	fields, err := profileFields(user)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.collection.UpdateByID(ctx, user.ID, bson.M{"$set": fields})
 	return err
 }
 
+// profileFields returns the fields of user written by UpdateUser. Balances
+// only change through the conditional balance and hold updates, so a profile
+// write made from a stale copy of the user cannot undo them.
+func profileFields(user *models.User) (bson.M, error) {
+	data, err := bson.Marshal(user)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields bson.M
+	if err := bson.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	delete(fields, "balance")
+	delete(fields, "held_balance")
+	return fields, nil
+}
+
 func (r *userRepository) DeleteUser(ctx context.Context, id string) error {
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
@@ -80,18 +104,132 @@ func (r *userRepository) DeleteUser(ctx context.Context, id string) error {
 	return err
 }
 
-// UpdateUserBalance changes a user’s balance.
-func (r *userRepository) UpdateUserBalance(ctx context.Context, id string, amount float64) error {
+// UpdateUserBalance changes a user’s balance. It returns ErrCurrencyMismatch
+// unless amount is in the currency of the balance.
+func (r *userRepository) UpdateUserBalance(ctx context.Context, id string, amount models.Money) error {
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		return err
 	}
-	_, err = r.collection.UpdateOne(
+	res, err := r.collection.UpdateOne(
 		ctx,
-		bson.M{"_id": objID},
-		bson.M{"$inc": bson.M{"balance": amount}},
+		bson.M{"_id": objID, "balance.currency": amount.Currency},
+		bson.M{"$inc": bson.M{"balance.units": amount.Units}},
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return models.ErrCurrencyMismatch
+	}
+	return nil
+}
+
+// SetBalance replaces a user's balance and moves the held balance to its
+// currency. A currency change is only applied while nothing is held;
+// otherwise ErrFundsHeld is returned.
+func (r *userRepository) SetBalance(ctx context.Context, id string, balance models.Money) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{
+		"_id": objID,
+		"$or": []bson.M{
+			{"balance.currency": balance.Currency},
+			{"held_balance.units": bson.M{"$in": bson.A{0, nil}}},
+		},
+	}
+	res, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{
+		"balance":               balance,
+		"held_balance.currency": balance.Currency,
+		"updated_at":            time.Now(),
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return models.ErrFundsHeld
+	}
+	return nil
+}
+
+// DebitBalance takes amount from a user's available balance. It returns
+// ErrInsufficientFunds if the available balance is too low or not in the
+// currency of amount.
+func (r *userRepository) DebitBalance(ctx context.Context, id string, amount models.Money) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.collection.UpdateOne(ctx, availableAtLeast(objID, amount), bson.M{
+		"$inc": bson.M{"balance.units": -amount.Units},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return models.ErrInsufficientFunds
+	}
+	return nil
+}
+
+// availableAtLeast matches the user if the balance minus the held balance
+// covers amount
+func availableAtLeast(id primitive.ObjectID, amount models.Money) bson.M {
+	return bson.M{
+		"_id":              id,
+		"balance.currency": amount.Currency,
+		"$expr": bson.M{"$gte": bson.A{
+			bson.M{"$subtract": bson.A{"$balance.units", bson.M{"$ifNull": bson.A{"$held_balance.units", 0}}}},
+			amount.Units,
+		}},
+	}
+}
+
+// ReserveBalance moves amount of a user's available balance into the held balance.
+// It returns ErrInsufficientFunds if the available balance is too low or not
+// in the currency of amount.
+func (r *userRepository) ReserveBalance(ctx context.Context, id string, amount models.Money) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.collection.UpdateOne(ctx, availableAtLeast(objID, amount), bson.M{
+		"$inc": bson.M{"held_balance.units": amount.Units},
+		"$set": bson.M{"held_balance.currency": amount.Currency},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return models.ErrInsufficientFunds
+	}
+	return nil
+}
+
+// AdjustHeldBalance changes a user's balance and held balance, in minor units
+// of currency, in one update.
+func (r *userRepository) AdjustHeldBalance(ctx context.Context, id, currency string, balanceDelta, heldDelta int64) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+	res, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": objID, "balance.currency": currency},
+		bson.M{"$inc": bson.M{"balance.units": balanceDelta, "held_balance.units": heldDelta}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return models.ErrCurrencyMismatch
+	}
+	return nil
 }
 
 // GetAllUsers retrieves users with pagination.
@@ -195,3 +333,127 @@ func (r *passwordResetTokenRepository) DeleteExpiredPasswordResetTokens(ctx cont
 	})
 	return err
 }
+
+// BalanceHoldRepository implementation
+type balanceHoldRepository struct {
+	collection *mongo.Collection
+}
+
+func NewBalanceHoldRepository(db *mongo.Database) *balanceHoldRepository {
+	return &balanceHoldRepository{
+		collection: db.Collection("balance_holds"),
+	}
+}
+
+// EnsureIndexes creates the index used to look up the holds of a reference
+func (r *balanceHoldRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "reference", Value: 1}, {Key: "created_at", Value: 1}},
+	})
+	return err
+}
+
+func (r *balanceHoldRepository) CreateHold(ctx context.Context, hold *models.BalanceHold) error {
+	res, err := r.collection.InsertOne(ctx, hold)
+	if err != nil {
+		return err
+	}
+	hold.ID = res.InsertedID.(primitive.ObjectID)
+	return nil
+}
+
+func (r *balanceHoldRepository) GetHold(ctx context.Context, id string) (*models.BalanceHold, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var hold models.BalanceHold
+	err = r.collection.FindOne(ctx, bson.M{"_id": objID}).Decode(&hold)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrNotFound
+		}
+		return nil, err
+	}
+	return &hold, nil
+}
+
+// SettleHold moves an active hold to its final status. Only one caller can
+// settle a hold; the others get ErrHoldNotActive.
+func (r *balanceHoldRepository) SettleHold(ctx context.Context, id string, status models.HoldStatus, capturedTo string) (*models.BalanceHold, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	set := bson.M{
+		"status":     status,
+		"updated_at": time.Now(),
+	}
+	if capturedTo != "" {
+		set["captured_to"] = capturedTo
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var hold models.BalanceHold
+	err = r.collection.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": objID, "status": models.HoldActive},
+		bson.M{"$set": set},
+		opts,
+	).Decode(&hold)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, err
+		}
+		if _, getErr := r.GetHold(ctx, id); getErr != nil {
+			return nil, getErr
+		}
+		return nil, models.ErrHoldNotActive
+	}
+	return &hold, nil
+}
+
+// ReactivateHold moves a hold settled as from back to ACTIVE, for a settlement
+// whose balance updates failed
+func (r *balanceHoldRepository) ReactivateHold(ctx context.Context, id string, from models.HoldStatus) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": objID, "status": from},
+		bson.M{
+			"$set":   bson.M{"status": models.HoldActive, "updated_at": time.Now()},
+			"$unset": bson.M{"captured_to": ""},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return models.ErrHoldNotActive
+	}
+	return nil
+}
+
+// ListHoldsByReference returns every hold placed for a reference, oldest first
+func (r *balanceHoldRepository) ListHoldsByReference(ctx context.Context, reference string) ([]*models.BalanceHold, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{"reference": reference}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var holds []*models.BalanceHold
+	if err := cursor.All(ctx, &holds); err != nil {
+		return nil, err
+	}
+	return holds, nil
+}
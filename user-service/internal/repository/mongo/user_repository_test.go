@@ -0,0 +1,123 @@
+package mongo
+
+import (
+	"context"
+	"cs2-marketplace-microservices/user-service/internal/models"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestProfileFieldsLeaveOutBalances(t *testing.T) {
+	user := &models.User{
+		ID:          primitive.NewObjectID(),
+		Username:    "alice",
+		Email:       "alice@example.com",
+		Balance:     models.Money{Units: 5000, Currency: "USD"},
+		HeldBalance: models.Money{Units: 1000, Currency: "USD"},
+	}
+
+	fields, err := profileFields(user)
+	if err != nil {
+		t.Fatalf("profileFields: %v", err)
+	}
+	for _, field := range []string{"balance", "held_balance"} {
+		if _, ok := fields[field]; ok {
+			t.Errorf("profile write sets %q", field)
+		}
+	}
+	if fields["username"] != "alice" || fields["email"] != "alice@example.com" {
+		t.Errorf("profile write lost profile fields: %v", fields)
+	}
+}
+
+// testDB connects to the database at MONGO_TEST_URI, skipping the test when it
+// is not set, and drops the database when the test ends
+func testDB(t *testing.T) *mongo.Database {
+	t.Helper()
+
+	uri := os.Getenv("MONGO_TEST_URI")
+	if uri == "" {
+		t.Skip("MONGO_TEST_URI not set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+
+	db := client.Database("user_service_test_" + primitive.NewObjectID().Hex())
+	t.Cleanup(func() {
+		_ = db.Drop(context.Background())
+		_ = client.Disconnect(context.Background())
+	})
+	return db
+}
+
+// A profile update written from a copy of the user read before a hold is
+// captured must not restore the balance from before the capture
+func TestUpdateUserDuringCapture(t *testing.T) {
+	db := testDB(t)
+	repo := NewUserRepository(db)
+	ctx := context.Background()
+
+	user, err := models.NewUser("buyer", "buyer@example.com", "secret", false, "USD")
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	user.ID = primitive.NewObjectID()
+	if err := repo.CreateUser(ctx, user); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	const captures = 20
+	const amount = 100
+	if err := repo.UpdateUserBalance(ctx, user.ID.Hex(), models.Money{Units: captures * amount, Currency: "USD"}); err != nil {
+		t.Fatalf("UpdateUserBalance: %v", err)
+	}
+	for range captures {
+		if err := repo.ReserveBalance(ctx, user.ID.Hex(), models.Money{Units: amount, Currency: "USD"}); err != nil {
+			t.Fatalf("ReserveBalance: %v", err)
+		}
+	}
+
+	stale, err := repo.GetUserByID(ctx, user.ID.Hex())
+	if err != nil {
+		t.Fatalf("GetUserByID: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := range captures {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if err := repo.AdjustHeldBalance(ctx, user.ID.Hex(), "USD", -amount, -amount); err != nil {
+				t.Errorf("capture: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			profile := *stale
+			profile.Username = "buyer" + string(rune('a'+i))
+			if err := repo.UpdateUser(ctx, &profile); err != nil {
+				t.Errorf("UpdateUser: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	got, err := repo.GetUserByID(ctx, user.ID.Hex())
+	if err != nil {
+		t.Fatalf("GetUserByID: %v", err)
+	}
+	if got.Balance.Units != 0 || got.HeldBalance.Units != 0 {
+		t.Errorf("balance %d held %d after capturing everything, want 0 and 0", got.Balance.Units, got.HeldBalance.Units)
+	}
+}
@@ -20,8 +20,10 @@ type UserUseCase struct {
 	userRepo    models.UserRepository
 	sessionRepo models.SessionRepository
 	tokenRepo   models.PasswordResetTokenRepository
+	holdRepo    models.BalanceHoldRepository
 	emailSender email.Sender
 	cache       *cache.Cache
+	currency    string // currency of new balances
 }
 
 var (
@@ -44,8 +46,10 @@ func NewUserUseCase(
 	ur models.UserRepository,
 	sr models.SessionRepository,
 	tr models.PasswordResetTokenRepository,
+	hr models.BalanceHoldRepository,
 	es email.Sender,
 	nats *messaging.Client,
+	currency string,
 ) *UserUseCase {
 	// Initialize cache with 5 minute default expiration and 10 minute cleanup interval
 	c := cache.New(5*time.Minute, 10*time.Minute)
@@ -54,8 +58,10 @@ func NewUserUseCase(
 		userRepo:    ur,
 		sessionRepo: sr,
 		tokenRepo:   tr,
+		holdRepo:    hr,
 		emailSender: es,
 		cache:       c,
+		currency:    currency,
 	}
 
 	// Subscribe to skin.created events
@@ -98,6 +104,11 @@ func (uc *UserUseCase) invalidateUserCache(userID, email, username string) {
 	log.Printf("Cache INVALIDATED for user: %s", userID)
 }
 
+func (uc *UserUseCase) invalidateBalanceCache(userID string) {
+	uc.cache.Delete(CacheKeyBalance + userID)
+	uc.cache.Delete(CacheKeyUserByID + userID)
+}
+
 // Auth Use Cases
 func (uc *UserUseCase) Register(ctx context.Context, username, email, password string) (*models.User, string, error) {
 	// Check if email exists (with cache)
@@ -122,7 +133,7 @@ func (uc *UserUseCase) Register(ctx context.Context, username, email, password s
 		isAdmin = true
 	}
 
-	user, err := models.NewUser(username, email, password, isAdmin)
+	user, err := models.NewUser(username, email, password, isAdmin, uc.currency)
 	if err != nil {
 		return nil, "", err
 	}
@@ -338,7 +349,9 @@ func (uc *UserUseCase) GetUserProfile(ctx context.Context, userID string) (*mode
 	return user, nil
 }
 
-func (uc *UserUseCase) UpdateUserProfile(ctx context.Context, userID, username, email string) (*models.User, error) {
+// UpdateUserProfile changes the username and email of a user. The leaderboard
+// opt-out is only changed when leaderboardOptOut is not nil.
+func (uc *UserUseCase) UpdateUserProfile(ctx context.Context, userID, username, email string, leaderboardOptOut *bool) (*models.User, error) {
 	user, err := uc.GetUserProfile(ctx, userID)
 	if err != nil {
 		return nil, err
@@ -369,6 +382,9 @@ func (uc *UserUseCase) UpdateUserProfile(ctx context.Context, userID, username,
 
 	user.Username = username
 	user.Email = email
+	if leaderboardOptOut != nil {
+		user.LeaderboardOptOut = *leaderboardOptOut
+	}
 	if err := uc.userRepo.UpdateUser(ctx, user); err != nil {
 		return nil, err
 	}
@@ -386,10 +402,10 @@ func (uc *UserUseCase) UpdateUserProfile(ctx context.Context, userID, username,
 }
 
 // Balance Use Cases
-func (uc *UserUseCase) GetBalance(ctx context.Context, userID string) (float64, error) {
+func (uc *UserUseCase) GetBalance(ctx context.Context, userID string) (*models.Balance, error) {
 	// Try cache first
 	if cached, found := uc.cache.Get(CacheKeyBalance + userID); found {
-		if balance, ok := cached.(float64); ok {
+		if balance, ok := cached.(*models.Balance); ok {
 			log.Printf("Balance cache HIT for user: %s", userID)
 			return balance, nil
 		}
@@ -397,18 +413,40 @@ func (uc *UserUseCase) GetBalance(ctx context.Context, userID string) (float64,
 
 	user, err := uc.GetUserProfile(ctx, userID) // This will use user cache
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+
+	balance := &models.Balance{
+		Total: user.Balance,
+		Held:  models.Money{Units: user.HeldBalance.Units, Currency: user.Balance.Currency},
 	}
 
 	// Cache the balance for 1 minute (shorter than user cache since balance changes more frequently)
-	uc.cache.Set(CacheKeyBalance+userID, user.Balance, 1*time.Minute)
+	uc.cache.Set(CacheKeyBalance+userID, balance, 1*time.Minute)
 	log.Printf("Balance cache SET for user: %s", userID)
 
-	return user.Balance, nil
+	return balance, nil
 }
 
-func (uc *UserUseCase) UpdateBalance(ctx context.Context, userID string, amount float64) error {
-	err := uc.userRepo.UpdateUserBalance(ctx, userID, amount)
+// UpdateBalance adds amount to the user's balance, converting it to the
+// currency of the balance with conversions if needed. A negative amount can
+// only take from the available balance, never from held funds.
+func (uc *UserUseCase) UpdateBalance(ctx context.Context, userID string, amount models.Money, conversions []models.Conversion) error {
+	user, err := uc.GetUserProfile(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	amount, err = models.Convert(amount, user.Balance.Currency, conversions)
+	if err != nil {
+		return err
+	}
+
+	if amount.Units < 0 {
+		err = uc.debitBalance(ctx, userID, amount.Neg())
+	} else {
+		err = uc.userRepo.UpdateUserBalance(ctx, userID, amount)
+	}
 	if err != nil {
 		return err
 	}
@@ -438,30 +476,42 @@ func (uc *UserUseCase) DeleteUser(ctx context.Context, userID string) error {
 	return nil
 }
 
-func (uc *UserUseCase) TransferBalance(ctx context.Context, fromUserID, toUserID string, amount float64) error {
+// TransferBalance moves amount from one user to another. Each side is converted
+// to the currency of its balance with conversions if needed.
+func (uc *UserUseCase) TransferBalance(ctx context.Context, fromUserID, toUserID string, amount models.Money, conversions []models.Conversion) error {
+	if amount.Units <= 0 {
+		return errors.New("amount must be positive")
+	}
+
 	// Verify sender has sufficient balance
 	fromUser, err := uc.GetUserProfile(ctx, fromUserID)
 	if err != nil {
 		return errors.New("sender not found")
 	}
 
-	if fromUser.Balance < amount {
-		return errors.New("insufficient balance")
-	}
-
 	// Verify recipient exists
-	if _, err := uc.GetUserProfile(ctx, toUserID); err != nil {
+	toUser, err := uc.GetUserProfile(ctx, toUserID)
+	if err != nil {
 		return errors.New("recipient not found")
 	}
 
-	// Perform transfer
-	if err := uc.userRepo.UpdateUserBalance(ctx, fromUserID, -amount); err != nil {
+	debit, err := models.Convert(amount, fromUser.Balance.Currency, conversions)
+	if err != nil {
+		return err
+	}
+	credit, err := models.Convert(amount, toUser.Balance.Currency, conversions)
+	if err != nil {
 		return err
 	}
 
-	if err := uc.userRepo.UpdateUserBalance(ctx, toUserID, amount); err != nil {
+	// Held funds are reserved for pending transactions and cannot be transferred
+	if err := uc.debitBalance(ctx, fromUserID, debit); err != nil {
+		return err
+	}
+
+	if err := uc.userRepo.UpdateUserBalance(ctx, toUserID, credit); err != nil {
 		// Attempt to rollback
-		_ = uc.userRepo.UpdateUserBalance(ctx, fromUserID, amount)
+		_ = uc.userRepo.UpdateUserBalance(ctx, fromUserID, debit)
 		return err
 	}
 
@@ -474,6 +524,162 @@ func (uc *UserUseCase) TransferBalance(ctx context.Context, fromUserID, toUserID
 	return nil
 }
 
+// debitBalance takes amount from the available balance in one conditional
+// update, so concurrent debits cannot spend the same funds
+func (uc *UserUseCase) debitBalance(ctx context.Context, userID string, amount models.Money) error {
+	err := uc.userRepo.DebitBalance(ctx, userID, amount)
+	if errors.Is(err, models.ErrInsufficientFunds) {
+		return ErrInsufficientBalance
+	}
+	return err
+}
+
+// PlaceHold reserves part of the user's available balance, e.g. for a pending
+// transaction. The hold is kept in the currency of the balance; amount is
+// converted with conversions if needed.
+func (uc *UserUseCase) PlaceHold(ctx context.Context, userID string, amount models.Money, conversions []models.Conversion, reference string) (*models.BalanceHold, error) {
+	if amount.Units <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	user, err := uc.GetUserProfile(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	amount, err = models.Convert(amount, user.Balance.Currency, conversions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepo.ReserveBalance(ctx, userID, amount); err != nil {
+		if errors.Is(err, models.ErrInsufficientFunds) {
+			return nil, ErrInsufficientBalance
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	hold := &models.BalanceHold{
+		UserID:    userID,
+		Amount:    amount,
+		Reference: reference,
+		Status:    models.HoldActive,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := uc.holdRepo.CreateHold(ctx, hold); err != nil {
+		// Attempt to give the reserved funds back
+		_ = uc.userRepo.AdjustHeldBalance(ctx, userID, amount.Currency, 0, -amount.Units)
+		return nil, err
+	}
+
+	uc.invalidateBalanceCache(userID)
+
+	return hold, nil
+}
+
+// CaptureHold debits the held funds from the user and credits them to toUserID.
+// If creditAmount is set only that part of the hold is credited, the rest is
+// only debited. If toUserID is empty the funds are only debited. A credit in
+// another currency than the hold or the recipient's balance is converted with
+// conversions.
+func (uc *UserUseCase) CaptureHold(ctx context.Context, holdID, toUserID string, creditAmount *models.Money, conversions []models.Conversion) (*models.BalanceHold, error) {
+	var recipient *models.User
+	if toUserID != "" {
+		var err error
+		recipient, err = uc.GetUserProfile(ctx, toUserID)
+		if err != nil {
+			return nil, errors.New("recipient not found")
+		}
+	}
+
+	hold, err := uc.holdRepo.GetHold(ctx, holdID)
+	if err != nil {
+		return nil, err
+	}
+
+	credit := hold.Amount
+	if creditAmount != nil {
+		credit, err = models.Convert(*creditAmount, hold.Amount.Currency, conversions)
+		if err != nil {
+			return nil, err
+		}
+		if credit.Units < 0 || credit.Units > hold.Amount.Units {
+			return nil, models.ErrInvalidCredit
+		}
+	}
+	if recipient != nil {
+		credit, err = models.Convert(credit, recipient.Balance.Currency, conversions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Settle the hold first so it can never be captured twice
+	hold, err = uc.holdRepo.SettleHold(ctx, holdID, models.HoldCaptured, toUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// On failure the hold goes back to ACTIVE, so it can be captured or
+	// released again
+	if err := uc.userRepo.AdjustHeldBalance(ctx, hold.UserID, hold.Amount.Currency, -hold.Amount.Units, -hold.Amount.Units); err != nil {
+		uc.reactivateHold(ctx, hold, models.HoldCaptured)
+		return nil, err
+	}
+
+	if recipient != nil && credit.Units > 0 {
+		if err := uc.userRepo.UpdateUserBalance(ctx, toUserID, credit); err != nil {
+			// Attempt to rollback
+			if rbErr := uc.userRepo.AdjustHeldBalance(ctx, hold.UserID, hold.Amount.Currency, hold.Amount.Units, hold.Amount.Units); rbErr != nil {
+				log.Printf("Failed to restore held balance of user %s for hold %s: %v", hold.UserID, hold.ID.Hex(), rbErr)
+			} else {
+				uc.reactivateHold(ctx, hold, models.HoldCaptured)
+			}
+			return nil, err
+		}
+		uc.invalidateBalanceCache(toUserID)
+	}
+
+	uc.invalidateBalanceCache(hold.UserID)
+
+	return hold, nil
+}
+
+// ReleaseHold returns the held funds to the user's available balance
+func (uc *UserUseCase) ReleaseHold(ctx context.Context, holdID string) (*models.BalanceHold, error) {
+	hold, err := uc.holdRepo.SettleHold(ctx, holdID, models.HoldReleased, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.userRepo.AdjustHeldBalance(ctx, hold.UserID, hold.Amount.Currency, 0, -hold.Amount.Units); err != nil {
+		uc.reactivateHold(ctx, hold, models.HoldReleased)
+		return nil, err
+	}
+
+	uc.invalidateBalanceCache(hold.UserID)
+
+	return hold, nil
+}
+
+// reactivateHold puts a hold whose settlement failed back to ACTIVE
+func (uc *UserUseCase) reactivateHold(ctx context.Context, hold *models.BalanceHold, from models.HoldStatus) {
+	if err := uc.holdRepo.ReactivateHold(ctx, hold.ID.Hex(), from); err != nil {
+		log.Printf("Failed to reactivate hold %s: %v", hold.ID.Hex(), err)
+	}
+}
+
+// ListHolds returns every hold placed for a reference, in any status
+func (uc *UserUseCase) ListHolds(ctx context.Context, reference string) ([]*models.BalanceHold, error) {
+	if reference == "" {
+		return nil, errors.New("reference is required")
+	}
+	return uc.holdRepo.ListHoldsByReference(ctx, reference)
+}
+
 // Admin Use Cases
 func (uc *UserUseCase) AdminGetAllUsers(ctx context.Context, page, limit int64) ([]*models.User, error) {
 	// Admin operations typically don't use cache as they need fresh data
@@ -502,12 +708,21 @@ func (uc *UserUseCase) AdminUpdateUser(ctx context.Context, adminID, userID stri
 	if updates.Email != "" {
 		user.Email = updates.Email
 	}
-	if updates.Balance != 0 {
-		user.Balance = updates.Balance
-	}
 	if updates.IsAdmin {
 		user.IsAdmin = updates.IsAdmin
 	}
+	if updates.Balance.Units != 0 {
+		if !models.ValidCurrency(updates.Balance.Currency) {
+			return nil, errors.New("balance must have an ISO 4217 currency code")
+		}
+		// Changing the currency of a balance with held funds would strand the
+		// holds, so the held balance is checked and moved in the same write
+		if err := uc.userRepo.SetBalance(ctx, userID, updates.Balance); err != nil {
+			return nil, err
+		}
+		user.Balance = updates.Balance
+		user.HeldBalance.Currency = updates.Balance.Currency
+	}
 
 	if err := uc.userRepo.UpdateUser(ctx, user); err != nil {
 		return nil, err
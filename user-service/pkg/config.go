@@ -20,6 +20,7 @@ type Config struct {
 	EmailUser     string
 	EmailPassword string
 	NATSURL       string `envconfig:"NATS_URL" default:"nats://localhost:4222"`
+	Currency      string // currency of new balances and of balances stored before balances had one
 }
 
 func Load() *Config {
@@ -28,5 +29,6 @@ func Load() *Config {
 		MongoDBName: getEnv("MONGO_DB", "user_service"),
 		GRPCPort:    getEnv("GRPC_PORT", ":50052"),
 		JWTSecret:   getEnv("JWT_SECRET", "secret"),
+		Currency:    getEnv("CURRENCY", "USD"),
 	}
 }